@@ -0,0 +1,344 @@
+package numwords
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidTime is returned by ParseTimeOfDay if the input is not a valid
+// time of day.
+var ErrInvalidTime = errors.New("the input is not a valid time of day")
+
+// TimeOfDay is a time on a 24-hour clock
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// String writes the time in 24-hour format (eg, "15:04").
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
+}
+
+type meridiem int8
+
+const (
+	meridiemNone meridiem = iota
+	meridiemAM
+	meridiemPM
+)
+
+// meridiems are the phrases that may end a time of day, mapped to whether they
+// refer to the morning or afternoon.
+var meridiems = []struct {
+	words []string
+	m     meridiem
+}{
+	{[]string{"am"}, meridiemAM},
+	{[]string{"a.m."}, meridiemAM},
+	{[]string{"a.m"}, meridiemAM},
+	{[]string{"in", "the", "morning"}, meridiemAM},
+	{[]string{"pm"}, meridiemPM},
+	{[]string{"p.m."}, meridiemPM},
+	{[]string{"p.m"}, meridiemPM},
+	{[]string{"in", "the", "afternoon"}, meridiemPM},
+	{[]string{"in", "the", "evening"}, meridiemPM},
+	{[]string{"at", "night"}, meridiemPM},
+}
+
+// clockWords join the minutes to the hour in phrases like "quarter past
+// three", mapped to whether the minutes are added to or subtracted from it.
+var clockWords = map[string]int{
+	"past":   1,
+	"after":  1,
+	"to":     -1,
+	"before": -1,
+	"till":   -1,
+	"til":    -1,
+}
+
+// clockHours are words that name an hour of the day on their own
+var clockHours = map[string]int{
+	"noon":     12,
+	"midday":   12,
+	"midnight": 0,
+}
+
+// ParseTimeOfDay reads a time of day, such as "quarter past three", "ten to
+// six", "seven o'clock", "half past nine p.m.", "noon", "eleven forty five" or
+// "fourteen hundred hours". Times without "a.m." or "p.m." are read on a
+// 24-hour clock. ErrInvalidTime is returned if the input is not a valid time.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	return defaultParser.ParseTimeOfDay(s)
+}
+
+// ParseTimeOfDay reads a time of day, such as "quarter past three", "ten to
+// six", "seven o'clock", "half past nine p.m.", "noon", "eleven forty five" or
+// "fourteen hundred hours". Times without "a.m." or "p.m." are read on a
+// 24-hour clock. ErrInvalidTime is returned if the input is not a valid time.
+func (p *Parser) ParseTimeOfDay(s string) (TimeOfDay, error) {
+	in := p.explode(strings.ToLower(s))
+	in, m := trimMeridiem(in)
+
+	mins, ok := p.clockMinutes(in, m)
+	if !ok {
+		return TimeOfDay{}, ErrInvalidTime
+	}
+
+	const day = 24 * 60
+	mins = (mins%day + day) % day
+	return TimeOfDay{mins / 60, mins % 60}, nil
+}
+
+// ClockMinutes reads the words as a time of day, returning the number of
+// minutes since midnight.
+func (p *Parser) clockMinutes(in []string, m meridiem) (int, bool) {
+	if len(in) == 0 {
+		return -1, false
+	}
+
+	last := len(in) - 1
+
+	for i, s := range in {
+		if dir, ok := clockWords[s]; ok {
+			mins, ok := p.minutesPhrase(in[:i])
+			if !ok {
+				return -1, false
+			}
+
+			hour, ok := p.hour(in[i+1:], m)
+			return hour*60 + dir*mins, ok
+		}
+	}
+
+	switch in[last] {
+	case "o'clock", "oclock":
+		hour, ok := p.hour(in[:last], m)
+		return hour * 60, ok
+	case "hours":
+		if m != meridiemNone {
+			return -1, false
+		}
+		return p.militaryTime(in[:last])
+	}
+
+	if len(in) == 1 {
+		if parts := strings.Split(in[0], ":"); len(parts) == 2 {
+			return p.digitalTime(parts, m)
+		}
+	}
+
+	if _, ok := clockHours[in[0]]; ok || m != meridiemNone {
+		if hour, ok := p.hour(in, m); ok {
+			return hour * 60, true
+		}
+	}
+
+	for i := 1; i < len(in); i++ {
+		hour, ok := p.hour(in[:i], m)
+		if !ok {
+			continue
+		}
+
+		if mins, ok := p.clockMinute(in[i:]); ok {
+			return hour*60 + mins, true
+		}
+	}
+
+	return -1, false
+}
+
+// Hour reads the words as an hour of the day, adjusted for the meridiem
+func (p *Parser) hour(in []string, m meridiem) (int, bool) {
+	if len(in) == 1 {
+		if hour, ok := clockHours[in[0]]; ok {
+			return hour, m == meridiemNone
+		}
+	}
+
+	hour, ok := p.wholeNumber(in)
+	return meridiemHour(hour, m), ok && validHour(hour, m)
+}
+
+// MinutesPhrase reads the minutes before "past" or "to" in a time of day,
+// such as "quarter", "a quarter", "half" or "twenty five minutes".
+func (p *Parser) minutesPhrase(in []string) (int, bool) {
+	if len(in) > 0 && (in[0] == "a" || in[0] == "an") {
+		in = in[1:]
+	}
+
+	if len(in) == 1 {
+		switch in[0] {
+		case "quarter":
+			return 15, true
+		case "half":
+			return 30, true
+		}
+	}
+
+	if len(in) > 1 {
+		switch in[len(in)-1] {
+		case "minute", "minutes", "min", "mins":
+			in = in[:len(in)-1]
+		}
+	}
+
+	mins, ok := p.wholeNumber(in)
+	return mins, ok && mins > 0 && mins < 60
+}
+
+// ClockMinute reads the minutes after the hour in a time of day, such as
+// "fifteen" in "three fifteen" or "oh five" in "seven oh five".
+func (p *Parser) clockMinute(in []string) (int, bool) {
+	if len(in) > 1 && (in[0] == "oh" || in[0] == "o" || in[0] == "zero") {
+		mins, ok := p.wholeNumber(in[1:])
+		return mins, ok && mins < 10
+	}
+
+	mins, ok := p.wholeNumber(in)
+	return mins, ok && mins >= 10 && mins < 60
+}
+
+// MilitaryTime reads a time of day on a 24-hour clock preceding "hours", such
+// as "fourteen hundred" or "oh six thirty".
+func (p *Parser) militaryTime(in []string) (int, bool) {
+	if len(in) > 1 && (in[0] == "oh" || in[0] == "o" || in[0] == "zero") {
+		in = in[1:]
+	}
+
+	if last := len(in) - 1; last > 0 && in[last] == "hundred" {
+		hour, ok := p.wholeNumber(in[:last])
+		return hour * 60, ok && hour < 24
+	}
+
+	for i := 1; i < len(in); i++ {
+		hour, ok := p.wholeNumber(in[:i])
+		if !ok || hour >= 24 {
+			continue
+		}
+
+		if mins, ok := p.clockMinute(in[i:]); ok {
+			return hour*60 + mins, true
+		}
+	}
+
+	return -1, false
+}
+
+// DigitalTime reads a time written with a colon (eg, "3:15").
+func (p *Parser) digitalTime(parts []string, m meridiem) (int, bool) {
+	hour, ok := p.wholeNumber(parts[:1])
+	if !ok || !validHour(hour, m) {
+		return -1, false
+	}
+
+	digits, ok := foldDigits(parts[1])
+	if !ok || len(digits) != 2 {
+		return -1, false
+	}
+
+	mins, _ := atoi(digits)
+	return meridiemHour(hour, m)*60 + mins, mins < 60
+}
+
+// WholeNumber reads the words as a single, non-negative whole number
+func (p *Parser) wholeNumber(in []string) (int, bool) {
+	ns, i := p.readNumbers(in, 0, nil)
+	if i != len(in) || len(ns) != 1 {
+		return -1, false
+	}
+
+	n := ns[0]
+	if n.ordinal || n.denominator != 1 || n.numerator < 0 || n.typ == numPercent {
+		return -1, false
+	}
+
+	return n.numerator, true
+}
+
+// TrimMeridiem removes any trailing "a.m." or "p.m." phrase from the words,
+// including those attached to a number (eg, "3pm").
+func trimMeridiem(in []string) ([]string, meridiem) {
+	if last := len(in) - 1; last >= 0 {
+		for _, m := range meridiems {
+			w := m.words[0]
+			if len(m.words) > 1 || !strings.HasSuffix(in[last], w) || len(in[last]) == len(w) {
+				continue
+			}
+
+			if r := []rune(strings.TrimSuffix(in[last], w)); isDigit(r[len(r)-1]) {
+				return append(in[:last:last], string(r)), m.m
+			}
+		}
+	}
+
+	for _, m := range meridiems {
+		if len(in) <= len(m.words) {
+			continue
+		}
+
+		tail := in[len(in)-len(m.words):]
+		if strings.Join(tail, " ") == strings.Join(m.words, " ") {
+			return in[:len(in)-len(m.words)], m.m
+		}
+	}
+
+	return in, meridiemNone
+}
+
+func validHour(hour int, m meridiem) bool {
+	if m == meridiemNone {
+		return hour >= 0 && hour < 24
+	}
+	return hour >= 1 && hour <= 12
+}
+
+func meridiemHour(hour int, m meridiem) int {
+	switch m {
+	case meridiemAM:
+		return hour % 12
+	case meridiemPM:
+		return hour%12 + 12
+	default:
+		return hour
+	}
+}
+
+// IsClockFraction returns true if the ith word is a fraction that begins a
+// time of day, such as "quarter" in "quarter past three" or "a" in "a quarter
+// to six". These are not read as numbers by ParseString. A fraction after a
+// number or "and" is part of that number instead (eg, "two and a half to
+// three").
+func isClockFraction(in []string, i int) bool {
+	start := i
+	if strings.EqualFold(in[i], "a") {
+		i++
+	} else if i > 0 && strings.EqualFold(in[i-1], "a") {
+		start--
+	}
+
+	if i+1 >= len(in) {
+		return false
+	}
+
+	switch strings.ToLower(in[i]) {
+	case "quarter", "half":
+		if _, ok := clockWords[strings.ToLower(in[i+1])]; !ok {
+			return false
+		}
+	default:
+		return false
+	}
+
+	return start == 0 || !endsNumber(in[start-1])
+}
+
+// EndsNumber returns true if the word may be the end of a number or an "and"
+// within one, such as "two" or "2" in "two and a half".
+func endsNumber(w string) bool {
+	if _, ok := lookupNumber(w); ok {
+		return true
+	}
+	return strings.IndexFunc(w, isDigit) == 0
+}
@@ -0,0 +1,85 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock_ParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"quarter past three", "03:15"},
+		{"a quarter past three", "03:15"},
+		{"half past nine", "09:30"},
+		{"half past nine p.m.", "21:30"},
+		{"ten to six", "05:50"},
+		{"twenty-five minutes after four", "04:25"},
+		{"a quarter to twelve", "11:45"},
+		{"ten to midnight", "23:50"},
+		{"quarter past noon", "12:15"},
+		{"five before seven in the evening", "18:55"},
+		{"seven o'clock", "07:00"},
+		{"Seven O'Clock AM", "07:00"},
+		{"twelve o'clock a.m.", "00:00"},
+		{"twelve pm", "12:00"},
+		{"three pm", "15:00"},
+		{"3pm", "15:00"},
+		{"3:15pm", "15:15"},
+		{"15:04", "15:04"},
+		{"noon", "12:00"},
+		{"midnight", "00:00"},
+		{"eleven forty five", "11:45"},
+		{"twenty three fifteen", "23:15"},
+		{"seven oh five", "07:05"},
+		{"seven oh five at night", "19:05"},
+		{"fourteen hundred hours", "14:00"},
+		{"zero six hundred hours", "06:00"},
+		{"twenty one hundred hours", "21:00"},
+		{"fourteen thirty hours", "14:30"},
+		{"oh nine forty five hours", "09:45"},
+	}
+
+	for _, test := range tests {
+		tod, err := ParseTimeOfDay(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, tod.String(), test.in)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"three",
+		"foo past three",
+		"quarter past",
+		"sixty past three",
+		"thirteen pm",
+		"twenty five hundred hours",
+		"three five",
+		"3:5",
+		"3:75",
+		"noon pm",
+		"three pm hours",
+		"second to last",
+	}
+
+	for _, in := range invalid {
+		_, err := ParseTimeOfDay(in)
+		assert.Equal(t, ErrInvalidTime, err, in)
+	}
+}
+
+func TestClock_IsClockFraction(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isClockFraction([]string{"quarter", "past", "three"}, 0))
+	assert.True(t, isClockFraction([]string{"a", "quarter", "to", "three"}, 0))
+	assert.True(t, isClockFraction([]string{"Half", "Past", "three"}, 0))
+	assert.False(t, isClockFraction([]string{"a", "quarter", "of", "it"}, 0))
+	assert.False(t, isClockFraction([]string{"ten", "to", "six"}, 0))
+	assert.False(t, isClockFraction([]string{"quarter"}, 0))
+}
@@ -0,0 +1,61 @@
+package numwords
+
+// Integer is a constraint for the integer types ParseAs can convert to
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint for the floating point types ParseAs can convert to
+type Float interface {
+	~float32 | ~float64
+}
+
+// ParseAs reads a text string and converts it to a value of type T (eg,
+// ParseAs[uint8]("two hundred") => 200). Integers are read exactly, without
+// rounding through a float64, and fractional portions of the number are
+// truncated for integer types (see WithRounding and ParseAsWith). ErrOutOfRange
+// is returned if the number does not fit in T. Numbers are read as int values,
+// so they are capped at math.MaxInt64 even for uint64 and float types (eg,
+// "ten quintillion" returns ErrOutOfRange). As with ParseInt, an error is
+// returned if the string cannot be resolved to a single number.
+func ParseAs[T Integer | Float](s string) (T, error) {
+	return ParseAsWith[T](defaultParser, s)
+}
+
+// ParseAsWith performs the same actions as ParseAs, reading the string with
+// the parser p.
+func ParseAsWith[T Integer | Float](p *Parser, s string) (T, error) {
+	ns, err := p.parse(s)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := p.ratios(ns).single()
+	if err != nil {
+		return 0, err
+	}
+
+	return convert[T](n, p.rounding)
+}
+
+// Convert converts the value of the number to T, rounding it with the mode for
+// integer types. ErrOutOfRange is returned if the value does not fit in T.
+func convert[T Integer | Float](n number, mode Rounding) (T, error) {
+	if one := T(1); one/2 != 0 { // T is a floating point type
+		if n.denominator == 1 {
+			return T(n.numerator), nil
+		}
+		return T(n.Value()), nil
+	}
+
+	i, err := n.round(mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if t := T(i); int(t) == i && (t < 0) == (i < 0) {
+		return t, nil
+	}
+	return 0, ErrOutOfRange
+}
@@ -0,0 +1,95 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert_ParseAs(t *testing.T) {
+	t.Parallel()
+
+	i64, err := ParseAs[int64]("nine quadrillion one")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(9000000000000001), i64)
+	}
+
+	i64, err = ParseAs[int64]("nine quintillion two hundred twenty three quadrillion three hundred seventy two trillion thirty six billion eight hundred fifty four million seven hundred seventy five thousand eight hundred seven")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(9223372036854775807), i64)
+	}
+
+	u8, err := ParseAs[uint8]("two hundred fifty five")
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint8(255), u8)
+	}
+
+	_, err = ParseAs[uint8]("two hundred fifty six")
+	assert.Equal(t, ErrOutOfRange, err)
+
+	i8, err := ParseAs[int8]("one hundred twenty seven")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int8(127), i8)
+	}
+
+	_, err = ParseAs[int8]("one hundred twenty eight")
+	assert.Equal(t, ErrOutOfRange, err)
+
+	u16, err := ParseAs[uint16]("two and a half")
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint16(2), u16, "truncated")
+	}
+
+	u64, err := ParseAs[uint64]("nine quintillion")
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint64(9000000000000000000), u64)
+	}
+
+	_, err = ParseAs[uint64]("ten quintillion")
+	assert.Equal(t, ErrOutOfRange, err, "numbers are capped at math.MaxInt64, even for uint64")
+
+	_, err = ParseAs[float64]("ten quintillion")
+	assert.Equal(t, ErrOutOfRange, err, "numbers are capped at math.MaxInt64, even for float64")
+
+	f32, err := ParseAs[float32]("two and a half")
+	if assert.NoError(t, err) {
+		assert.Equal(t, float32(2.5), f32)
+	}
+
+	f64, err := ParseAs[float64]("nine quadrillion one")
+	if assert.NoError(t, err) {
+		assert.Equal(t, float64(9000000000000001), f64)
+	}
+
+	type count uint32
+	c, err := ParseAs[count]("a dozen")
+	if assert.NoError(t, err) {
+		assert.Equal(t, count(12), c)
+	}
+
+	_, err = ParseAs[int]("foo")
+	assert.Equal(t, ErrNonNumber, err)
+
+	_, err = ParseAs[int]("two three")
+	assert.Equal(t, ErrManyNumbers, err)
+}
+
+func TestConvert_ParseAsWith(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithPercentages(PercentRatio))
+	f, err := ParseAsWith[float64](p, "fifty percent")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0.5, f)
+	}
+
+	i, err := ParseAsWith[int](p, "fifty percent")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, i)
+	}
+
+	i, err = ParseAsWith[int](New(WithLenient(true)), "about twenty five items")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 25, i)
+	}
+}
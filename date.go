@@ -0,0 +1,187 @@
+package numwords
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDate is returned by ParseDate if the input is not a date or
+// describes a date that does not exist (eg, "the thirty-first of February").
+var ErrInvalidDate = errors.New("the input is not a valid date")
+
+// DateOrder determines the order of the day and month in dates written only
+// with digits (eg, "07/10/1990"). Dates with month names are unambiguous.
+type DateOrder int8
+
+const (
+	// MonthDayYear reads "07/10/1990" as July 10th, 1990.
+	MonthDayYear DateOrder = iota
+
+	// DayMonthYear reads "07/10/1990" as October 7th, 1990.
+	DayMonthYear
+)
+
+// months map the case-insensitive names and abbreviations of each month
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// ParseDate reads a date, such as "the twenty-first of March nineteen ninety",
+// "March 21st, 1990" or "07/10/1990", returning midnight UTC on that day.
+// Spoken years may be written in full ("two thousand and four"), in pairs
+// ("nineteen ninety") or with "oh" ("nineteen oh eight"). ErrInvalidDate is
+// returned if the input is not a date or describes one that does not exist.
+func ParseDate(s string) (time.Time, error) {
+	return defaultParser.ParseDate(s)
+}
+
+// ParseDate reads a date, such as "the twenty-first of March nineteen ninety",
+// "March 21st, 1990" or "07/10/1990", returning midnight UTC on that day.
+// Spoken years may be written in full ("two thousand and four"), in pairs
+// ("nineteen ninety") or with "oh" ("nineteen oh eight"). ErrInvalidDate is
+// returned if the input is not a date or describes one that does not exist.
+func (p *Parser) ParseDate(s string) (time.Time, error) {
+	in := p.explode(strings.ToLower(s))
+
+	words := in[:0:0]
+	for _, w := range in {
+		if w != "the" && w != "of" && w != "on" {
+			words = append(words, w)
+		}
+	}
+
+	for i, w := range words {
+		if m, ok := months[w]; ok {
+			return p.spokenDate(words[:i], m, words[i+1:])
+		}
+	}
+
+	return p.numericDate(words)
+}
+
+// SpokenDate reads a date with a month name, with the day either before the
+// month ("the first of May") or after it ("May first").
+func (p *Parser) spokenDate(before []string, m time.Month, after []string) (time.Time, error) {
+	if len(before) > 0 {
+		day, ok := p.day(before)
+		year, yok := p.year(after)
+		if !ok || !yok {
+			return time.Time{}, ErrInvalidDate
+		}
+		return date(year, m, day)
+	}
+
+	for i := 1; i < len(after); i++ {
+		day, ok := p.day(after[:i])
+		if !ok {
+			continue
+		}
+
+		if year, ok := p.year(after[i:]); ok {
+			return date(year, m, day)
+		}
+	}
+
+	return time.Time{}, ErrInvalidDate
+}
+
+// NumericDate reads a date written only with digits, either as a single word
+// ("07/10/1990" or "10.07.1990") or three words ("1990-07-10" once split). Dates
+// beginning with a four-digit year are read as year, month, day; otherwise the
+// parser's DateOrder is used.
+func (p *Parser) numericDate(in []string) (time.Time, error) {
+	if len(in) == 1 {
+		in = strings.FieldsFunc(in[0], func(r rune) bool { return r == '/' || r == '.' })
+	}
+
+	if len(in) != 3 {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	parts := make([]int, 3)
+	for i, s := range in {
+		digits, ok := foldDigits(s)
+		if !ok {
+			return time.Time{}, ErrInvalidDate
+		}
+		parts[i], _ = atoi(digits)
+	}
+
+	if len(in[0]) == 4 {
+		return date(parts[0], time.Month(parts[1]), parts[2])
+	}
+
+	if p.dateOrder == DayMonthYear {
+		parts[0], parts[1] = parts[1], parts[0]
+	}
+
+	return date(parts[2], time.Month(parts[0]), parts[1])
+}
+
+// Day reads the words as a day of the month, either as an ordinal
+// ("twenty-first") or a cardinal number ("twenty one").
+func (p *Parser) day(in []string) (int, bool) {
+	ns, i := p.readNumbers(in, 0, nil)
+	if i != len(in) || len(ns) != 1 || ns[0].denominator != 1 {
+		return -1, false
+	}
+
+	day := ns[0].numerator
+	return day, day >= 1 && day <= 31
+}
+
+// Year reads the words as a year. In addition to whole numbers ("two thousand
+// and four"), pairs of two-digit numbers ("twenty twenty") and years with "oh"
+// ("nineteen oh eight") are read.
+func (p *Parser) year(in []string) (int, bool) {
+	if year, ok := p.wholeNumber(in); ok {
+		return year, year > 0
+	}
+
+	for i := 1; i < len(in); i++ {
+		century, ok := p.wholeNumber(in[:i])
+		if !ok || century < 10 || century > 99 {
+			continue
+		}
+
+		rest := in[i:]
+		low, high := 10, 99
+		if rest[0] == "oh" || rest[0] == "o" {
+			rest, low, high = rest[1:], 1, 9
+		}
+
+		if y, ok := p.wholeNumber(rest); ok && y >= low && y <= high {
+			return century*100 + y, true
+		}
+	}
+
+	return -1, false
+}
+
+// Date creates the date at midnight UTC, returning an error if it does not
+// exist (eg, February 31st).
+func date(year int, m time.Month, day int) (time.Time, error) {
+	if m < time.January || m > time.December || year < 1 {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	t := time.Date(year, m, day, 0, 0, 0, 0, time.UTC)
+	if day < 1 || t.Day() != day {
+		return time.Time{}, fmt.Errorf("%w: %s %d, %d does not exist", ErrInvalidDate, m, day, year)
+	}
+
+	return t, nil
+}
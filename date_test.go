@@ -0,0 +1,95 @@
+package numwords
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_ParseDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"the twenty-first of March nineteen ninety", "1990-03-21"},
+		{"twenty first of march 1990", "1990-03-21"},
+		{"March twenty-first, nineteen ninety", "1990-03-21"},
+		{"March the twenty first nineteen ninety", "1990-03-21"},
+		{"March 21st, 1990", "1990-03-21"},
+		{"21 Mar 1990", "1990-03-21"},
+		{"March twenty one nineteen ninety", "1990-03-21"},
+		{"on the second of May two thousand and four", "2004-05-02"},
+		{"the fourth of July seventeen seventy six", "1776-07-04"},
+		{"the first of sept nineteen oh eight", "1908-09-01"},
+		{"December thirty-first twenty twenty", "2020-12-31"},
+		{"the twenty-ninth of February two thousand", "2000-02-29"},
+		{"07/10/1990", "1990-07-10"},
+		{"1990-07-10", "1990-07-10"},
+	}
+
+	for _, test := range tests {
+		d, err := ParseDate(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, d.Format("2006-01-02"), test.in)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"March",
+		"the twenty-first of March",
+		"the thirty-first of February nineteen ninety",
+		"the twenty-ninth of February nineteen hundred",
+		"the thirty-second of March nineteen ninety",
+		"a half of March nineteen ninety",
+		"13/10/1990",
+		"foo bar baz",
+	}
+
+	for _, in := range invalid {
+		_, err := ParseDate(in)
+		assert.True(t, errors.Is(err, ErrInvalidDate), in)
+	}
+}
+
+func TestDate_Year(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   []string
+		year int
+		ok   bool
+	}{
+		{[]string{"nineteen", "ninety"}, 1990, true},
+		{[]string{"nineteen", "oh", "eight"}, 1908, true},
+		{[]string{"twenty", "twenty", "four"}, 2024, true},
+		{[]string{"two", "thousand", "and", "four"}, 2004, true},
+		{[]string{"1990"}, 1990, true},
+		{[]string{"nineteen", "oh", "twelve"}, -1, false},
+		{[]string{"foo"}, -1, false},
+		{[]string{}, -1, false},
+	}
+
+	for _, test := range tests {
+		year, ok := defaultParser.year(test.in)
+		assert.Equal(t, test.ok, ok, "%+v", test)
+		if test.ok {
+			assert.Equal(t, test.year, year, "%+v", test)
+		}
+	}
+}
+
+func TestDate_Date(t *testing.T) {
+	t.Parallel()
+
+	d, err := date(2000, time.February, 29)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC), d)
+
+	_, err = date(1990, time.February, 31)
+	assert.EqualError(t, err, "the input is not a valid date: February 31, 1990 does not exist")
+}
@@ -14,7 +14,8 @@ var dictionary = struct {
 	m: map[string]number{
 		// Direct
 		"zero":      {0, 1, numDirect, false},
-		"a":         {1, 1, numDirect, false},
+		"a":         {1, 1, numDirect, false}, // see isArticle
+		"an":        {1, 1, numDirect, false},
 		"ten":       {10, 1, numDirect, false},
 		"eleven":    {11, 1, numDirect, false},
 		"twelve":    {12, 1, numDirect, false},
@@ -51,49 +52,54 @@ var dictionary = struct {
 		"ninety":  {90, 1, numTens, false},
 
 		// Bigs
-		"hundred":  {100, 1, numBig, false},
-		"thousand": {1000, 1, numBig, false},
-		"million":  {1000000, 1, numBig, false},
-		"billion":  {1000000000, 1, numBig, false},
-		"trillion": {1000000000000, 1, numBig, false},
+		"hundred":     {100, 1, numBig, false},
+		"thousand":    {1000, 1, numBig, false},
+		"million":     {1000000, 1, numBig, false},
+		"billion":     {1000000000, 1, numBig, false},
+		"trillion":    {1000000000000, 1, numBig, false},
+		"quadrillion": {1000000000000000, 1, numBig, false},
+		"quintillion": {1000000000000000000, 1, numBig, false},
+		"dozen":       {12, 1, numBig, false},
 
 		// Fractions
-		"half":         {1, 2, numFraction, false},
-		"halve":        {1, 2, numFraction, false},
-		"halfs":        {1, 2, numFraction, false},
-		"halves":       {1, 2, numFraction, false},
-		"thirds":       {1, 3, numFraction, false},
-		"fourths":      {1, 4, numFraction, false},
-		"quarter":      {1, 4, numFraction, false},
-		"quarters":     {1, 4, numFraction, false},
-		"fifths":       {1, 5, numFraction, false},
-		"sixths":       {1, 6, numFraction, false},
-		"sevenths":     {1, 7, numFraction, false},
-		"eighths":      {1, 8, numFraction, false},
-		"nineths":      {1, 9, numFraction, false},
-		"tenths":       {1, 10, numFraction, false},
-		"elevenths":    {1, 11, numFraction, false},
-		"twelfths":     {1, 12, numFraction, false},
-		"thirteenths":  {1, 13, numFraction, false},
-		"fourteenths":  {1, 14, numFraction, false},
-		"fifteenths":   {1, 15, numFraction, false},
-		"sixteenths":   {1, 16, numFraction, false},
-		"seventeenths": {1, 17, numFraction, false},
-		"eighteenths":  {1, 18, numFraction, false},
-		"nineteenths":  {1, 19, numFraction, false},
-		"twentieths":   {1, 20, numFraction, false},
-		"thirtieths":   {1, 30, numFraction, false},
-		"fourtieths":   {1, 40, numFraction, false},
-		"fiftieths":    {1, 50, numFraction, false},
-		"sixtieths":    {1, 60, numFraction, false},
-		"seventieths":  {1, 70, numFraction, false},
-		"eightieths":   {1, 80, numFraction, false},
-		"ninetieths":   {1, 90, numFraction, false},
-		"hundredths":   {1, 100, numFraction, false},
-		"thousandths":  {1, 1000, numFraction, false},
-		"millionths":   {1, 1000000, numFraction, false},
-		"billionths":   {1, 1000000000, numFraction, false},
-		"trillionths":  {1, 1000000000000, numFraction, false},
+		"half":           {1, 2, numFraction, false},
+		"halve":          {1, 2, numFraction, false},
+		"halfs":          {1, 2, numFraction, false},
+		"halves":         {1, 2, numFraction, false},
+		"thirds":         {1, 3, numFraction, false},
+		"fourths":        {1, 4, numFraction, false},
+		"quarter":        {1, 4, numFraction, false},
+		"quarters":       {1, 4, numFraction, false},
+		"fifths":         {1, 5, numFraction, false},
+		"sixths":         {1, 6, numFraction, false},
+		"sevenths":       {1, 7, numFraction, false},
+		"eighths":        {1, 8, numFraction, false},
+		"nineths":        {1, 9, numFraction, false},
+		"tenths":         {1, 10, numFraction, false},
+		"elevenths":      {1, 11, numFraction, false},
+		"twelfths":       {1, 12, numFraction, false},
+		"thirteenths":    {1, 13, numFraction, false},
+		"fourteenths":    {1, 14, numFraction, false},
+		"fifteenths":     {1, 15, numFraction, false},
+		"sixteenths":     {1, 16, numFraction, false},
+		"seventeenths":   {1, 17, numFraction, false},
+		"eighteenths":    {1, 18, numFraction, false},
+		"nineteenths":    {1, 19, numFraction, false},
+		"twentieths":     {1, 20, numFraction, false},
+		"thirtieths":     {1, 30, numFraction, false},
+		"fourtieths":     {1, 40, numFraction, false},
+		"fiftieths":      {1, 50, numFraction, false},
+		"sixtieths":      {1, 60, numFraction, false},
+		"seventieths":    {1, 70, numFraction, false},
+		"eightieths":     {1, 80, numFraction, false},
+		"ninetieths":     {1, 90, numFraction, false},
+		"hundredths":     {1, 100, numFraction, false},
+		"thousandths":    {1, 1000, numFraction, false},
+		"millionths":     {1, 1000000, numFraction, false},
+		"billionths":     {1, 1000000000, numFraction, false},
+		"trillionths":    {1, 1000000000000, numFraction, false},
+		"quadrillionths": {1, 1000000000000000, numFraction, false},
+		"quintillionths": {1, 1000000000000000000, numFraction, false},
 
 		// Direct Ordinals
 		"zeroth":      {0, 1, numDirectOrdinal, true},
@@ -130,11 +136,13 @@ var dictionary = struct {
 		"ninetieth":  {90, 1, numTensOrdinal, true},
 
 		// Big Ordinals
-		"hundredth":  {100, 1, numBigOrdinal, true},
-		"thousandth": {1000, 1, numBigOrdinal, true},
-		"millionth":  {1000000, 1, numBigOrdinal, true},
-		"billionth":  {1000000000, 1, numBigOrdinal, true},
-		"trillionth": {1000000000000, 1, numBigOrdinal, true},
+		"hundredth":     {100, 1, numBigOrdinal, true},
+		"thousandth":    {1000, 1, numBigOrdinal, true},
+		"millionth":     {1000000, 1, numBigOrdinal, true},
+		"billionth":     {1000000000, 1, numBigOrdinal, true},
+		"trillionth":    {1000000000000, 1, numBigOrdinal, true},
+		"quadrillionth": {1000000000000000, 1, numBigOrdinal, true},
+		"quintillionth": {1000000000000000000, 1, numBigOrdinal, true},
 
 		// Glue
 		"and": {0, 0, numAnd, false},
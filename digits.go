@@ -0,0 +1,165 @@
+package numwords
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrNotDigits is returned by ParseDigits if the input is not a sequence of
+// digits.
+var ErrNotDigits = errors.New("the input is not a sequence of digits")
+
+// digitRepeats are the words that repeat the digit after them (eg, "double
+// three" => "33")
+var digitRepeats = map[string]int{
+	"double": 2,
+	"triple": 3,
+	"treble": 3,
+}
+
+// digitZeros are the multipliers that append zeros to the digits before them
+// (eg, "eight hundred" => "800")
+var digitZeros = map[string]string{
+	"hundred":  "00",
+	"thousand": "000",
+}
+
+// ParseDigits reads a sequence of digits spoken one or two at a time, such as
+// a phone number, PIN or zip code, returning the digits as a string (eg, "five
+// five five, oh one double two" => "5550122"). "oh" and "o" are read as zero,
+// "double" and "triple" repeat the digit after them, numbers from ten to
+// ninety-nine are read as two digits (eg, "twenty one" => "21") and "hundred"
+// and "thousand" append zeros to end a group (eg, "eight hundred" => "800").
+// Digits may also be written, ignoring punctuation (eg, "(555) 010-1234").
+// ErrNotDigits is returned if the input contains anything else.
+func ParseDigits(s string) (string, error) {
+	return defaultParser.ParseDigits(s)
+}
+
+// ParseDigits reads a sequence of digits spoken one or two at a time, such as
+// a phone number, PIN or zip code, returning the digits as a string (eg, "five
+// five five, oh one double two" => "5550122"). "oh" and "o" are read as zero,
+// "double" and "triple" repeat the digit after them, numbers from ten to
+// ninety-nine are read as two digits (eg, "twenty one" => "21") and "hundred"
+// and "thousand" append zeros to end a group (eg, "eight hundred" => "800").
+// Digits may also be written, ignoring punctuation (eg, "(555) 010-1234").
+// ErrNotDigits is returned if the input contains anything else.
+func (p *Parser) ParseDigits(s string) (string, error) {
+	in := p.explode(strings.ToLower(s))
+
+	words := in[:0:0]
+	for _, w := range in {
+		if !isPunct(w) {
+			words = append(words, w)
+		}
+	}
+
+	var b strings.Builder
+	afterDigit := false
+
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+
+		if n, ok := digitRepeats[w]; ok {
+			if i+1 >= len(words) {
+				return "", ErrNotDigits
+			}
+			d, ok := spokenDigit(words[i+1])
+			if !ok {
+				return "", ErrNotDigits
+			}
+			b.WriteString(strings.Repeat(d, n))
+			i++
+			afterDigit = true
+			continue
+		}
+
+		if zeros, ok := digitZeros[w]; ok {
+			// the zeros must end a group, not start a number with more to it
+			// (eg, "five hundred fifty five")
+			if !afterDigit || i+1 < len(words) && isTwoDigits(words[i+1]) {
+				return "", ErrNotDigits
+			}
+			b.WriteString(zeros)
+			continue
+		}
+
+		if d, ok := spokenDigit(w); ok {
+			b.WriteString(d)
+			afterDigit = true
+			continue
+		}
+
+		if n, ok := lookupNumber(w); ok && isTwoDigits(w) {
+			if n.typ == numTens && i+1 < len(words) {
+				if u, ok := lookupNumber(words[i+1]); ok && u.typ == numSingle {
+					n.numerator += u.numerator
+					i++
+				}
+			}
+			b.WriteString(strconv.Itoa(n.numerator))
+			afterDigit = true
+			continue
+		}
+
+		if d, ok := writtenDigits(w); ok {
+			b.WriteString(d)
+			afterDigit = true
+			continue
+		}
+
+		return "", ErrNotDigits
+	}
+
+	if b.Len() == 0 {
+		return "", ErrNotDigits
+	}
+
+	return b.String(), nil
+}
+
+// SpokenDigit returns the digit of a word for a single digit, reading "oh" and
+// "o" as zero. A single written digit is also accepted (eg, "double 3").
+func spokenDigit(w string) (string, bool) {
+	if w == "oh" || w == "o" {
+		return "0", true
+	}
+
+	if d, ok := writtenDigits(w); ok && len(d) == 1 && isDigits(w) {
+		return d, true
+	}
+
+	n, ok := lookupNumber(w)
+	if !ok || n.ordinal || n.denominator != 1 || n.numerator < 0 || n.numerator > 9 || isArticle(w) {
+		return "", false
+	}
+
+	return strconv.Itoa(n.numerator), true
+}
+
+// IsTwoDigits returns true if the word is a number read as two digits, a teen
+// or a multiple of ten (eg, "twelve" or "twenty"). Multipliers such as "dozen"
+// are not.
+func isTwoDigits(w string) bool {
+	n, ok := lookupNumber(w)
+	return ok && !n.ordinal && n.denominator == 1 && n.numerator >= 10 &&
+		(n.typ == numDirect || n.typ == numTens)
+}
+
+// WrittenDigits folds the digits of a word written with digits in any script,
+// ignoring any punctuation between them (eg, "555.0100").
+func writtenDigits(w string) (string, bool) {
+	var b strings.Builder
+	for _, r := range w {
+		switch {
+		case isDigit(r):
+			b.WriteByte(byte('0' + digitValue(r)))
+		case unicode.IsPunct(r):
+		default:
+			return "", false
+		}
+	}
+	return b.String(), b.Len() > 0
+}
@@ -0,0 +1,69 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigits_ParseDigits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"five five five one two one two", "5551212"},
+		{"Five Five Five, one two one two.", "5551212"},
+		{"double three", "33"},
+		{"triple seven", "777"},
+		{"treble two", "222"},
+		{"double oh seven", "007"},
+		{"oh one two", "012"},
+		{"o four", "04"},
+		{"zero nine", "09"},
+		{"nine one one", "911"},
+		{"one eight hundred five five five", "1800555"},
+		{"nine thousand", "9000"},
+		{"one hundred thousand", "100000"},
+		{"nineteen hundred", "1900"},
+		{"twenty one twelve", "2112"},
+		{"nineteen eighty four", "1984"},
+		{"ten twenty", "1020"},
+		{"double 3", "33"},
+		{"(555) 010-1234", "5550101234"},
+		{"555.010.1234", "5550101234"},
+		{"five five five - oh one double two", "5550122"},
+		{"٣ two", "32"},
+	}
+
+	for _, test := range tests {
+		out, err := ParseDigits(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, out, test.in)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"foo",
+		"five apples",
+		"double",
+		"double twenty",
+		"double double two",
+		"hundred",
+		"dozen",
+		"one dozen",
+		"five hundred fifty five",
+		"two thousand twelve",
+		"one hundred twentieth",
+		"a one",
+		"one half",
+		"1.5 and two",
+	}
+
+	for _, in := range invalid {
+		_, err := ParseDigits(in)
+		assert.Equal(t, ErrNotDigits, err, in)
+	}
+}
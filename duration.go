@@ -0,0 +1,118 @@
+package numwords
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDuration is returned by ParseDuration if the input is not a valid
+// length of time.
+var ErrInvalidDuration = errors.New("the input is not a valid duration")
+
+// ParseDuration reads a length of time, such as "two and a half hours", "a
+// minute and a half", "half an hour" or "one hour twenty minutes", with units
+// from milliseconds through weeks. ErrInvalidDuration is returned if the input
+// is not a valid duration.
+func ParseDuration(s string) (time.Duration, error) {
+	return defaultParser.ParseDuration(s)
+}
+
+// ParseDuration reads a length of time, such as "two and a half hours", "a
+// minute and a half", "half an hour" or "one hour twenty minutes", with units
+// from milliseconds through weeks. ErrInvalidDuration is returned if the input
+// is not a valid duration.
+func (p *Parser) ParseDuration(s string) (time.Duration, error) {
+	in := p.splitUnits(p.explode(strings.ToLower(s)))
+	if p.couple {
+		in = couples(in)
+	}
+
+	if len(in) == 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	total := new(big.Rat)
+	for i := 0; i < len(in); {
+		if i > 0 && in[i] == "and" {
+			if i++; i >= len(in) {
+				return 0, ErrInvalidDuration
+			}
+		}
+
+		q, end, ok := p.matchDuration(in, i)
+		if !ok || q.Value.Sign() < 0 {
+			return 0, ErrInvalidDuration
+		}
+
+		total.Add(total, new(big.Rat).Mul(q.Value, q.Unit.Factor))
+		i = end
+	}
+
+	total.Mul(total, big.NewRat(int64(time.Second), 1))
+	d := new(big.Int).Quo(total.Num(), total.Denom())
+	if !d.IsInt64() {
+		return 0, ErrInvalidDuration
+	}
+
+	return time.Duration(d.Int64()), nil
+}
+
+// MatchDuration attempts to read a quantity of time starting at the ith word,
+// returning it along with the index of the first word after it. In addition to
+// the quantities read by ExtractQuantities, a trailing fraction is a fraction
+// of the unit (eg, "an hour and a half").
+func (p *Parser) matchDuration(in []string, i int) (q Quantity, end int, ok bool) {
+	if q, end, ok = p.matchQuantity(in, i); !ok || q.Unit.Dimension != Time {
+		return q, i, false
+	}
+
+	return q, p.readUnitFraction(in, end, &q), true
+}
+
+// ReadUnitFraction reads a fraction of the quantity's unit following it, such
+// as "and a half" in "an hour and a half", adding it to the quantity. The index
+// of the first word after it is returned.
+func (p *Parser) readUnitFraction(in []string, i int, q *Quantity) int {
+	if i >= len(in) || in[i] != "and" {
+		return i
+	}
+
+	ns, j := p.readNumbers(in, i+1, p.isUnit)
+	if len(ns) != 1 || ns[0].ordinal || ns[0].numerator <= 0 || ns[0].numerator >= ns[0].denominator {
+		return i
+	}
+
+	if _, ok := p.unit(in, j); ok {
+		return i
+	}
+
+	q.Value.Add(q.Value, big.NewRat(int64(ns[0].numerator), int64(ns[0].denominator)))
+	return j
+}
+
+// Couples replaces "a couple of" and "couple of" with "2"
+func couples(in []string) []string {
+	out := make([]string, 0, len(in))
+
+	for i := 0; i < len(in); i++ {
+		j := i
+		if in[j] == "a" {
+			j++
+		}
+
+		if j < len(in) && in[j] == "couple" {
+			if j+1 < len(in) && in[j+1] == "of" {
+				j++
+			}
+			out = append(out, "2")
+			i = j
+			continue
+		}
+
+		out = append(out, in[i])
+	}
+
+	return out
+}
@@ -0,0 +1,76 @@
+package numwords
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuration_ParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out time.Duration
+	}{
+		{"two and a half hours", 150 * time.Minute},
+		{"a minute and a half", 90 * time.Second},
+		{"an hour and a half", 90 * time.Minute},
+		{"half an hour", 30 * time.Minute},
+		{"a quarter of an hour", 15 * time.Minute},
+		{"three days", 72 * time.Hour},
+		{"one hour twenty minutes", 80 * time.Minute},
+		{"one hour and twenty minutes", 80 * time.Minute},
+		{"an hour twenty", 80 * time.Minute},
+		{"2 hours, 30 minutes", 150 * time.Minute},
+		{"1 week 2 days", 9 * 24 * time.Hour},
+		{"two weeks and three days", 17 * 24 * time.Hour},
+		{"ten minutes and thirty seconds", 630 * time.Second},
+		{"5min", 5 * time.Minute},
+		{"one hundred milliseconds", 100 * time.Millisecond},
+		{"A Second", time.Second},
+	}
+
+	for _, test := range tests {
+		d, err := ParseDuration(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, d, test.in)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"hours",
+		"two",
+		"two kilograms",
+		"two hours and a foo",
+		"two hours and",
+		"the third hour",
+		"a foo",
+		"one billion weeks",
+	}
+
+	for _, in := range invalid {
+		_, err := ParseDuration(in)
+		assert.Equal(t, ErrInvalidDuration, err, in)
+	}
+}
+
+func TestDuration_Couples(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  []string
+		out []string
+	}{
+		{[]string{"a", "couple", "of", "minutes"}, []string{"2", "minutes"}},
+		{[]string{"couple", "hours"}, []string{"2", "hours"}},
+		{[]string{"a", "minute"}, []string{"a", "minute"}},
+		{[]string{"a"}, []string{"a"}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, couples(test.in), "%+v", test)
+	}
+}
@@ -0,0 +1,129 @@
+package numwords
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Trace describes how a run of words was reduced to numbers
+type Trace struct {
+	// Words are the words read into the buffer of numbers.
+	Words []string
+
+	// Numbers are the numbers read from the words, before any rules are applied.
+	Numbers []string
+
+	// Steps are the rules applied to the numbers, in order. They are only
+	// recorded by EnginePatterns.
+	Steps []Step
+
+	// Trees are the parse trees of the numbers, one per reduced number (eg,
+	// "(scale 2 100)"). They are only recorded by EngineGrammar.
+	Trees []string
+
+	// Result is the reduced set of numbers.
+	Result []string
+}
+
+// Step is a single rule applied while reducing numbers
+type Step struct {
+	// Pattern is the pattern of number types the rule was matched against. See
+	// Rule for the meaning of each letter.
+	Pattern string
+
+	// Rule is the rule that was applied.
+	Rule Rule
+
+	// RuleIndex is the position of the rule in the RuleSet's Rules.
+	RuleIndex int
+
+	// Offset is the position in Pattern where the rule matched.
+	Offset int
+
+	// Numbers are the numbers after the rule was applied.
+	Numbers []string
+}
+
+// Explain reads a text string like ParseString, returning how each run of
+// numbers written to the output was reduced. Amounts of money read with
+// WithMoney are not included.
+func Explain(s string) []Trace {
+	return defaultParser.Explain(s)
+}
+
+// Explain reads a text string like ParseString, returning how each run of
+// numbers written to the output was reduced. Amounts of money read with
+// WithMoney are not included.
+func (p *Parser) Explain(s string) []Trace {
+	var traces []Trace
+
+	tp := *p
+	tp.traces = &traces
+	tp.ParseString(s)
+
+	return traces
+}
+
+// Reduce reduces the numbers read from the words, recording a Trace if the
+// parser is explaining its input.
+func (p *Parser) reduce(words []string, ns numbers) numbers {
+	if p.traces == nil || len(ns) == 0 {
+		return p.evaluate(ns)
+	}
+
+	t := Trace{
+		Words:   append([]string(nil), words...),
+		Numbers: traceStrings(ns),
+	}
+
+	if p.engine == EngineGrammar {
+		trees := parseGrammar(ns)
+		for _, nd := range trees {
+			t.Trees = append(t.Trees, nd.String())
+		}
+		ns = values(trees)
+	} else {
+		ns, _ = p.rules.trace(ns, nil, func(s Step) {
+			t.Steps = append(t.Steps, s)
+		})
+	}
+
+	t.Result = traceStrings(ns)
+	*p.traces = append(*p.traces, t)
+	return ns
+}
+
+// String returns a readable, multi-line representation of the trace
+func (t Trace) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "%s => %s\n", strings.Join(t.Words, " "), strings.Join(t.Numbers, " "))
+	for _, s := range t.Steps {
+		b.WriteString("  " + s.String() + "\n")
+	}
+	for _, tree := range t.Trees {
+		b.WriteString("  " + tree + "\n")
+	}
+	fmt.Fprintf(b, "  = %s", strings.Join(t.Result, " "))
+	return b.String()
+}
+
+// String returns a readable representation of the step
+func (s Step) String() string {
+	return fmt.Sprintf("%s [%d] %s %s => %s (%s)",
+		s.Pattern, s.Offset, s.Rule.Pattern, s.Rule.Action, strings.Join(s.Numbers, " "), s.Rule.Description)
+}
+
+// TraceStrings gets the string representations of each number, naming the
+// glue words and signs that have no value of their own
+func traceStrings(ns numbers) []string {
+	buf := ns.strings()
+	for i, n := range ns {
+		switch n.typ {
+		case numAnd:
+			buf[i] = "and"
+		case numPercentSign:
+			buf[i] = "%"
+		}
+	}
+	return buf
+}
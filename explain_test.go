@@ -0,0 +1,99 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain_Explain(t *testing.T) {
+	t.Parallel()
+
+	traces := Explain("fifteen hundredth and two fourth apples")
+	if assert.Len(t, traces, 2) {
+		tr := traces[0]
+		assert.Equal(t, []string{"fifteen", "hundredth"}, tr.Words)
+		assert.Equal(t, []string{"15", "100th"}, tr.Numbers)
+		if assert.Len(t, tr.Steps, 1) {
+			assert.Equal(t, "dB", tr.Steps[0].Pattern)
+			assert.Equal(t, "dB", tr.Steps[0].Rule.Pattern)
+			assert.Equal(t, "fractionOrCombine", tr.Steps[0].Rule.Action.String())
+			assert.Equal(t, 0, tr.Steps[0].Offset)
+			assert.Equal(t, "dB", defaultRuleSet.Rules()[tr.Steps[0].RuleIndex].Pattern)
+			assert.Equal(t, []string{"1500th"}, tr.Steps[0].Numbers)
+		}
+		assert.Equal(t, []string{"1500th"}, tr.Result)
+
+		tr = traces[1]
+		assert.Equal(t, []string{"two", "fourth"}, tr.Words)
+		if assert.Len(t, tr.Steps, 1) {
+			assert.Equal(t, "sS", tr.Steps[0].Pattern)
+			assert.Equal(t, "fractionOrDone", tr.Steps[0].Rule.Action.String())
+		}
+		assert.Equal(t, []string{"2", "4th"}, tr.Result)
+	}
+
+	assert.Empty(t, Explain("no numbers here"))
+}
+
+func TestExplain_Steps(t *testing.T) {
+	t.Parallel()
+
+	traces := Explain("two thousand and twenty five and a half")
+	if !assert.Len(t, traces, 1) {
+		return
+	}
+
+	var patterns, rules []string
+	for _, s := range traces[0].Steps {
+		patterns = append(patterns, s.Pattern)
+		rules = append(rules, s.Rule.Pattern)
+	}
+
+	assert.Equal(t, []string{"2", "1000", "and", "20", "5", "and", "1", "0.5"}, traces[0].Numbers)
+	assert.Equal(t, []string{"sb&ts&df", "sb&t&df", "b&t&df", "b&t&f", "b&f"}, patterns)
+	assert.Equal(t, []string{"ts", "sb", "df", "t&f", "b&f"}, rules)
+	assert.Equal(t, []string{"2025.5"}, traces[0].Result)
+}
+
+func TestExplain_Parser(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithPercentages(PercentValue), WithRules(mustRuleSet(append(DefaultRules(),
+		Rule{"ss", 5, ActionMultiply, "two three => 6"})...)))
+
+	traces := p.Explain("two three percent")
+	if assert.Len(t, traces, 1) {
+		assert.Equal(t, []string{"2", "3", "%"}, traces[0].Numbers)
+		if assert.Len(t, traces[0].Steps, 2) {
+			assert.Equal(t, "ss", traces[0].Steps[0].Rule.Pattern)
+			assert.Equal(t, "s%", traces[0].Steps[1].Rule.Pattern)
+		}
+		assert.Equal(t, []string{"6%"}, traces[0].Result)
+	}
+
+	assert.Equal(t, "6%", p.ParseString("two three percent"), "explaining does not change the parser")
+}
+
+func TestExplain_String(t *testing.T) {
+	t.Parallel()
+
+	traces := Explain("twenty first")
+	if assert.Len(t, traces, 1) {
+		assert.Equal(t, "twenty first => 20 1st\n"+
+			"  tS [0] tS add => 21st (twenty first => 21st)\n"+
+			"  = 21st", traces[0].String())
+	}
+}
+
+func TestExplain_Money(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithMoney(true))
+	traces := p.Explain("five pounds fifty, for lunch and twenty two apples")
+	assert.Equal(t, "£5.50, for lunch and 22 apples", p.ParseString("five pounds fifty, for lunch and twenty two apples"))
+	if assert.Len(t, traces, 1) {
+		assert.Equal(t, []string{"twenty", "two"}, traces[0].Words)
+		assert.Equal(t, []string{"22"}, traces[0].Result)
+	}
+}
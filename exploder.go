@@ -1,21 +1,169 @@
 package numwords
 
 import (
-	"bufio"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
-func explode(s string) (out []string) {
-	s = strings.Replace(s, "-", " ", -1)
-	s = strings.Replace(s, ",", "", -1)
+// token is a word split from the input, along with any punctuation before or
+// after it (eg, "(seven)," => "(" "seven" "),"). Only the core is read as a
+// number. The span covers the whole word, including its punctuation.
+type token struct {
+	pre, core, post string
+	Span
+}
+
+// String rejoins the token with its punctuation
+func (t token) String() string {
+	return t.pre + t.core + t.post
+}
 
-	r := strings.NewReader(s)
-	scanner := bufio.NewScanner(r)
-	scanner.Split(bufio.ScanWords)
+func explode(s string) []string {
+	return cores(tokenize(s))
+}
+
+// Tokenize splits the string into tokens with the default Tokenizer
+func tokenize(s string) []token {
+	return splitWords(WordTokenizer{}.Tokenize(s))
+}
 
-	for scanner.Scan() {
-		out = append(out, scanner.Text())
+// SplitWords converts the words from a Tokenizer into tokens. The text of each
+// word is normalized and split at hyphens and dashes, its punctuation is
+// separated, and words that touch at punctuation are rejoined.
+func splitWords(in []Token) (out []token) {
+	for _, w := range in {
+		for _, t := range splitHyphens(w) {
+			last := len(out) - 1
+
+			switch {
+			case last >= 0 && out[last].End == t.Start && (out[last].post != "" || t.pre != "" || isPunct(out[last].core) || isPunct(t.core)):
+				out[last] = joinTokens(out[last], t)
+			case last >= 0 && out[last].post == "" && t.pre == "" && strings.EqualFold(out[last].core, "per") && strings.EqualFold(t.core, "cent"):
+				out[last].core += " " + t.core
+				out[last].post, out[last].End = t.post, t.End
+			default:
+				out = append(out, t)
+			}
+		}
 	}
 
 	return
 }
+
+// SplitHyphens normalizes the word and splits it at any hyphens or dashes,
+// returning the tokens with punctuation separated.
+func splitHyphens(w Token) (out []token) {
+	var b strings.Builder
+	start, end := -1, -1
+
+	flush := func() {
+		if b.Len() > 0 {
+			t := splitToken(b.String())
+			t.Span = Span{w.Start + start, w.Start + end}
+			out = append(out, t)
+		}
+		b.Reset()
+		start = -1
+	}
+
+	for i, r := range w.Text {
+		switch n := normalizeRune(r); n {
+		case '-':
+			flush()
+		case -1:
+		default:
+			if start < 0 {
+				start = i
+			}
+			end = i + utf8.RuneLen(r)
+			b.WriteRune(n)
+		}
+	}
+	flush()
+
+	return
+}
+
+// JoinTokens rejoins two touching tokens into one, separating the punctuation
+// of the combined word.
+func joinTokens(a, b token) token {
+	t := splitToken(a.String() + b.String())
+	t.Span = Span{a.Start, b.End}
+	return t
+}
+
+// IsPunct returns true if s is made up entirely of punctuation
+func isPunct(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPunct(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// SplitTokens splits the punctuation from each of the pre-split words
+func splitTokens(in []string) []token {
+	out := make([]token, len(in))
+	for i, s := range in {
+		out[i] = splitToken(s)
+	}
+	return out
+}
+
+// SplitToken separates any leading and trailing punctuation from the word. A
+// word made up entirely of punctuation (eg, "&") is left as the core, as is a
+// leading decimal point (eg, ".5").
+func splitToken(s string) token {
+	rs := []rune(s)
+
+	i, j := 0, len(rs)
+	for i < j && isWordPunct(rs[i]) && !(rs[i] == '.' && i+1 < j && isDigit(rs[i+1])) {
+		i++
+	}
+	for j > i && isWordPunct(rs[j-1]) {
+		j--
+	}
+
+	if i == j {
+		return token{core: s}
+	}
+
+	return token{pre: string(rs[:i]), core: string(rs[i:j]), post: string(rs[j:])}
+}
+
+// Cores returns the core of each token
+func cores(toks []token) []string {
+	out := make([]string, len(toks))
+	for i, t := range toks {
+		out[i] = t.core
+	}
+	return out
+}
+
+// IsWordPunct returns true if r is punctuation that may be split from either
+// end of a word. Percent signs are kept, as they are part of the number.
+func isWordPunct(r rune) bool {
+	return unicode.IsPunct(r) && r != '%'
+}
+
+// NormalizeRune replaces typographic quotes and dashes with their ASCII
+// equivalents and drops zero-width characters.
+func normalizeRune(r rune) rune {
+	switch r {
+	case '\u2018', '\u2019', '\u201a', '\u201b', '\u2032': // ‘ ’ ‚ ‛ ′
+		return '\''
+	case '\u201c', '\u201d', '\u201e', '\u201f', '\u2033': // “ ” „ ‟ ″
+		return '"'
+	case '\u2010', '\u2011', '\u2012', '\u2013', '\u2014', '\u2015', '\u2212': // hyphens, dashes & minus
+		return '-'
+	case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff': // zero-width
+		return -1
+	}
+	return r
+}
+
+func isGroupSpace(r rune) bool {
+	return r == '\u00a0' || r == '\u2009' || r == '\u202f'
+}
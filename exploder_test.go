@@ -13,7 +13,14 @@ func TestExploder_Explode(t *testing.T) {
 		in       string
 		expected []string
 	}{
-		{"1,000,000 dollars", []string{"1000000", "dollars"}},
+		{"1,000,000 dollars", []string{"1,000,000", "dollars"}},
+		{"1.234,56 euros", []string{"1.234,56", "euros"}},
+		{"1\u2009000\u202f000 dollars", []string{"1\u2009000\u202f000", "dollars"}},
+		{"3\u00a0apples", []string{"3", "apples"}},
+		{"one, two, 3, 4", []string{"one", "two", "3", "4"}},
+		{"(one) \u201ctwo\u201d", []string{"one", "two"}},
+		{"ten per cent off", []string{"ten", "per cent", "off"}},
+		{"per person", []string{"per", "person"}},
 		{"Foo Bar", []string{"Foo", "Bar"}},
 		{"twenty-one", []string{"twenty", "one"}},
 		{"Nintey-Nine Red Balloons, by Nena", []string{"Nintey", "Nine", "Red", "Balloons", "by", "Nena"}},
@@ -23,3 +30,58 @@ func TestExploder_Explode(t *testing.T) {
 		assert.EqualValues(t, test.expected, explode(test.in), "%+v", test)
 	}
 }
+
+func TestExploder_Tokenize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []token
+	}{
+		{"Three,", []token{{"", "Three", ",", Span{0, 6}}}},
+		{"(seven)", []token{{"(", "seven", ")", Span{0, 7}}}},
+		{"\u2018twelve\u2019", []token{{"'", "twelve", "'", Span{0, 12}}}},
+		{"SEVENTY\u2014FIVE!", []token{{"", "SEVENTY", "", Span{0, 7}}, {"", "FIVE", "!", Span{10, 15}}}},
+		{"fif\u200bteen", []token{{"", "fifteen", "", Span{0, 10}}}},
+		{"o\u2019clock", []token{{"", "o'clock", "", Span{0, 9}}}},
+		{"50%.", []token{{"", "50%", ".", Span{0, 4}}}},
+		{"1,000,", []token{{"", "1,000", ",", Span{0, 6}}}},
+		{"p.m.", []token{{"", "p.m", ".", Span{0, 4}}}},
+		{"two & three", []token{{"", "two", "", Span{0, 3}}, {"", "&", "", Span{4, 5}}, {"", "three", "", Span{6, 11}}}},
+		{"...", []token{{"", "...", "", Span{0, 3}}}},
+		{"(per cent)", []token{{"(", "per cent", ")", Span{0, 10}}}},
+		{"per, cent", []token{{"", "per", ",", Span{0, 4}}, {"", "cent", "", Span{5, 9}}}},
+		{" twenty-one ", []token{{"", "twenty", "", Span{1, 7}}, {"", "one", "", Span{8, 11}}}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, tokenize(test.in), "%+v", test)
+	}
+}
+
+func TestExploder_SplitWords(t *testing.T) {
+	t.Parallel()
+
+	in := []Token{
+		{"(", Span{0, 1}},
+		{"seven", Span{1, 6}},
+		{")", Span{6, 7}},
+		{"twenty", Span{8, 14}},
+		{"-", Span{14, 15}},
+		{"five", Span{15, 19}},
+		{"50", Span{20, 22}},
+		{"%", Span{22, 23}},
+		{",", Span{23, 24}},
+		{"&", Span{25, 26}},
+	}
+
+	expected := []token{
+		{"(", "seven", ")", Span{0, 7}},
+		{"", "twenty", "", Span{8, 14}},
+		{"", "five", "", Span{15, 19}},
+		{"", "50%", ",", Span{20, 24}},
+		{"", "&", "", Span{25, 26}},
+	}
+
+	assert.Equal(t, expected, splitWords(in))
+}
@@ -0,0 +1,221 @@
+package numwords
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NumberFormat describes the separators used when numbers are written with
+// digits. Regardless of the format, thin spaces, no-break spaces and
+// apostrophes are also accepted as group separators (eg, "1 000" or "1'000").
+type NumberFormat struct {
+	// Group separates each group of three digits in the integer portion of a
+	// number, such as the comma in "1,000". If Group is a space, adjacent
+	// digit groups split by the tokenizer are joined back together.
+	Group rune
+
+	// Decimal separates the integer and fractional portions of a number, such
+	// as the period in "1.5".
+	Decimal rune
+}
+
+var (
+	// DefaultNumberFormat reads numbers like "1,234.56"
+	DefaultNumberFormat = NumberFormat{Group: ',', Decimal: '.'}
+
+	// EuropeanNumberFormat reads numbers like "1.234,56"
+	EuropeanNumberFormat = NumberFormat{Group: '.', Decimal: ','}
+
+	// SpaceNumberFormat reads numbers like "1 234,56"
+	SpaceNumberFormat = NumberFormat{Group: ' ', Decimal: ','}
+
+	// SwissNumberFormat reads numbers like "1'234.56"
+	SwissNumberFormat = NumberFormat{Group: '\'', Decimal: '.'}
+)
+
+// FractionFormat determines how ParseString writes numbers with a fractional
+// portion.
+type FractionFormat int8
+
+const (
+	// FractionAsWritten writes fractions as decimals (eg, "2.5"), except that
+	// fractions written with a slash in the input (eg, "2 1/2") are left intact.
+	FractionAsWritten FractionFormat = iota
+
+	// FractionDecimal writes all fractions as decimals (eg, "2.5").
+	FractionDecimal
+
+	// FractionSlash writes all fractions with a slash (eg, "2 1/2").
+	FractionSlash
+)
+
+// IsGroup returns true if r may separate digit groups in this format.
+func (f NumberFormat) isGroup(r rune) bool {
+	if r == f.Decimal {
+		return false
+	}
+
+	switch r {
+	case f.Group, '\'', '\u2019', '\u00a0', '\u2009', '\u202f':
+		return true
+	}
+
+	return false
+}
+
+// Parse reads a number written with digits, returning it as an exact fraction.
+// Numbers with a decimal portion are typed as fractions; the type of all other
+// numbers is left for the caller to determine.
+func (f NumberFormat) parse(s string) (n number, ok bool) {
+	neg := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, frac, decimal := s, "", false
+	if idx := strings.IndexRune(s, f.Decimal); f.Decimal != 0 && idx >= 0 {
+		whole = s[:idx]
+		frac = s[idx+len(string(f.Decimal)):]
+		decimal = true
+	}
+
+	if whole, ok = f.ungroup(whole); !ok {
+		return
+	}
+
+	if !decimal {
+		if n.numerator, ok = atoi(whole); ok {
+			n.denominator = 1
+		}
+	} else if frac, ok = foldDigits(frac); ok {
+		rat, _ := new(big.Rat).SetString(whole + "." + frac)
+		if ok = rat.Num().IsInt64() && rat.Denom().IsInt64(); ok {
+			n.numerator = int(rat.Num().Int64())
+			n.denominator = int(rat.Denom().Int64())
+			n.typ = numFraction
+		}
+	}
+
+	if neg {
+		n.numerator = -n.numerator
+	}
+
+	return
+}
+
+// Ungroup strips the group separators from the integer portion of a number,
+// validating that each group after the first contains exactly three digits and
+// that only one kind of separator is used.
+func (f NumberFormat) ungroup(s string) (string, bool) {
+	var b strings.Builder
+	sep, size := rune(-1), 0
+
+	for _, r := range s {
+		switch {
+		case isDigit(r):
+			b.WriteByte(byte('0' + digitValue(r)))
+			size++
+		case f.isGroup(r) && (sep < 0 || r == sep):
+			if size == 0 || size > 3 || (sep >= 0 && size != 3) {
+				return "", false
+			}
+			sep, size = r, 0
+		default:
+			return "", false
+		}
+	}
+
+	if sep >= 0 && size != 3 {
+		return "", false
+	}
+
+	return b.String(), true
+}
+
+// JoinGroups merges adjacent tokens that together form a single number when
+// the format's group separator is a space (eg, "1" "234" => "1 234").
+func (f NumberFormat) joinGroups(in []token) []token {
+	out := make([]token, 0, len(in))
+
+	for _, t := range in {
+		if last := len(out) - 1; last >= 0 && out[last].post == "" && t.pre == "" && isDigits(leadingGroup(t.core)) {
+			joined := out[last].core + string(f.Group) + t.core
+			if _, ok := f.parse(joined); ok {
+				if _, ok = f.parse(out[last].core); ok {
+					out[last].core, out[last].post, out[last].End = joined, t.post, t.End
+					continue
+				}
+			}
+		}
+		out = append(out, t)
+	}
+
+	return out
+}
+
+// LeadingGroup returns the first three runes of s, provided the rune after them
+// does not continue the run of digits.
+func leadingGroup(s string) string {
+	rs := []rune(s)
+	if len(rs) < 3 || (len(rs) > 3 && isDigit(rs[3])) {
+		return ""
+	}
+	return string(rs[:3])
+}
+
+// IsDigit returns true if r is a decimal digit in any script (eg, "3", "٣" or "３").
+func isDigit(r rune) bool {
+	return unicode.IsDigit(r)
+}
+
+// DigitValue returns the value of the decimal digit r. Every range of decimal
+// digits in the Unicode tables begins with a zero and runs in order, so the
+// value is the offset of r within its range.
+func digitValue(r rune) int {
+	if '0' <= r && r <= '9' {
+		return int(r - '0')
+	}
+
+	for _, rng := range unicode.Nd.R16 {
+		if rune(rng.Lo) <= r && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+
+	for _, rng := range unicode.Nd.R32 {
+		if rune(rng.Lo) <= r && r <= rune(rng.Hi) {
+			return int(r-rune(rng.Lo)) % 10
+		}
+	}
+
+	return -1
+}
+
+// FoldDigits converts a string of decimal digits in any script to ASCII digits.
+func foldDigits(s string) (string, bool) {
+	var b strings.Builder
+	for _, r := range s {
+		if !isDigit(r) {
+			return "", false
+		}
+		b.WriteByte(byte('0' + digitValue(r)))
+	}
+	return b.String(), s != ""
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !isDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func atoi(s string) (int, bool) {
+	i, err := strconv.Atoi(s)
+	return i, err == nil
+}
@@ -0,0 +1,74 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		f   NumberFormat
+		in  string
+		val float64
+		ok  bool
+	}{
+		{DefaultNumberFormat, "1234", 1234, true},
+		{DefaultNumberFormat, "1,234", 1234, true},
+		{DefaultNumberFormat, "1,234,567.5", 1234567.5, true},
+		{DefaultNumberFormat, "0.1", 0.1, true},
+		{DefaultNumberFormat, ".5", 0.5, true},
+		{DefaultNumberFormat, "-12", -12, true},
+		{DefaultNumberFormat, "1'000", 1000, true},
+		{DefaultNumberFormat, "1 000", 1000, true},
+		{DefaultNumberFormat, "1 000 000", 1000000, true},
+		{DefaultNumberFormat, "1,2", 0, false},
+		{DefaultNumberFormat, "1,2345", 0, false},
+		{DefaultNumberFormat, "1234,567", 0, false},
+		{DefaultNumberFormat, ",123", 0, false},
+		{DefaultNumberFormat, "1,000'000", 0, false},
+		{DefaultNumberFormat, "1.5,000", 0, false},
+		{DefaultNumberFormat, "5.", 0, false},
+		{DefaultNumberFormat, "", 0, false},
+		{DefaultNumberFormat, "foo", 0, false},
+
+		{EuropeanNumberFormat, "1.234,56", 1234.56, true},
+		{EuropeanNumberFormat, "1.234", 1234, true},
+		{EuropeanNumberFormat, "0,5", 0.5, true},
+		{EuropeanNumberFormat, "1,234.56", 0, false},
+
+		{SpaceNumberFormat, "1 234,5", 1234.5, true},
+		{SwissNumberFormat, "1'234'567.25", 1234567.25, true},
+	}
+
+	for _, test := range tests {
+		n, ok := test.f.parse(test.in)
+		if assert.Equal(t, test.ok, ok, "%+v", test) && ok {
+			assert.Equal(t, test.val, n.Value(), "%+v", test)
+		}
+	}
+}
+
+func TestFormat_JoinGroups(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       []string
+		expected []string
+	}{
+		{[]string{"1", "234"}, []string{"1 234"}},
+		{[]string{"12", "345", "678,9", "apples"}, []string{"12 345 678,9", "apples"}},
+		{[]string{"3", "4", "apples"}, []string{"3", "4", "apples"}},
+		{[]string{"1234", "567"}, []string{"1234", "567"}},
+		{[]string{"1", "2345"}, []string{"1", "2345"}},
+		{[]string{"foo", "123"}, []string{"foo", "123"}},
+		{[]string{"(1", "234)"}, []string{"1 234"}},
+		{[]string{"1,", "234"}, []string{"1", "234"}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, cores(SpaceNumberFormat.joinGroups(splitTokens(test.in))), "%+v", test)
+	}
+}
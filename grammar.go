@@ -0,0 +1,506 @@
+package numwords
+
+import "strings"
+
+// Engine determines how a Parser combines adjacent numbers into their values
+// (eg, "one hundred" => 100).
+type Engine int8
+
+const (
+	// EnginePatterns repeatedly applies the Parser's rules to the pattern of
+	// number types until none match. It is the default.
+	EnginePatterns Engine = iota
+
+	// EngineGrammar parses the numbers with a grammar of number phrases,
+	// building a parse tree for each. Rules set with WithRules are not used.
+	// Both engines agree on well-formed numbers, but a run of numbers that is
+	// not a single phrase may be split differently (eg, "ten eleven twelve").
+	EngineGrammar
+)
+
+// The grammar of number phrases, using the type letters described by Rule:
+//
+//   phrase   = year | quantity [ "%" ] | any
+//   year     = ( d | t ) ( d | t [ s ] )         19 88, 20 10
+//   quantity = cardinal [ tail ] | f | /
+//   cardinal = ( small | b ) { [ & ] small | b } 2 100 and 5 1000
+//   small    = d | s | t [ s ]                   5, 20 5
+//   tail     = f | / | [ & ] ordinal | & fraction
+//   fraction = f | / | cardinal f | 1 ordinal
+//   ordinal  = D | S | T | B
+//
+// A small number may only follow a multiplier (b) and years are limited to
+// those read by yearOrDone. A phrase that is none of these is a single number
+// left as it is, except "and" which is dropped.
+
+// nodeKind identifies the production that built a node
+type nodeKind int8
+
+const (
+	nodeNumber   nodeKind = iota // a single number (eg, "five")
+	nodeTens                     // tens followed by units (eg, "twenty five")
+	nodeScale                    // numbers multiplied by a multiplier (eg, "two hundred")
+	nodeCardinal                 // scaled numbers added together (eg, "two thousand five")
+	nodeYear                     // a colloquial year (eg, "nineteen eighty")
+	nodeFraction                 // a number of fractions (eg, "three quarters")
+	nodeOrdinal                  // a number ending with an ordinal (eg, "twenty first")
+	nodeMixed                    // a whole number and a fraction (eg, "two and a half")
+	nodePercent                  // a percentage (eg, "five percent")
+)
+
+var nodeNames = map[nodeKind]string{
+	nodeTens:     "tens",
+	nodeScale:    "scale",
+	nodeCardinal: "cardinal",
+	nodeYear:     "year",
+	nodeFraction: "fraction",
+	nodeOrdinal:  "ordinal",
+	nodeMixed:    "mixed",
+	nodePercent:  "percent",
+}
+
+// node is a node in the parse tree of a phrase. Only nodeNumber nodes have no
+// children.
+type node struct {
+	kind     nodeKind
+	n        number
+	children []*node
+
+	// start and end are the range of numbers read by a phrase
+	start, end int
+}
+
+// String returns the parse tree in a prefix notation (eg, "(tens 20 5)")
+func (nd *node) String() string {
+	if nd.kind == nodeNumber {
+		return traceStrings(numbers{nd.n})[0]
+	}
+
+	s := make([]string, 0, len(nd.children)+1)
+	s = append(s, nodeNames[nd.kind])
+	for _, c := range nd.children {
+		s = append(s, c.String())
+	}
+	return "(" + strings.Join(s, " ") + ")"
+}
+
+// HasScale returns true if the node contains the multiplier m
+func (nd *node) hasScale(m int) bool {
+	if nd.kind == nodeNumber {
+		return nd.n.typ == numBig && nd.n.numerator == m
+	}
+
+	for _, c := range nd.children {
+		if c.hasScale(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Multiplier returns the multiplier applied last to the value of the node (eg,
+// 1000 for "two hundred five thousand"), or zero if there is none.
+func (nd *node) multiplier() int {
+	switch {
+	case nd.kind == nodeNumber && nd.n.typ == numBig:
+		return nd.n.numerator
+	case nd.kind == nodeScale:
+		return nd.children[1].n.numerator
+	}
+	return 0
+}
+
+// Value evaluates the node, combining the numbers of its children with the
+// same handlers used by the rules.
+func (nd *node) value() number {
+	switch nd.kind {
+	case nodeNumber:
+		return nd.n
+	case nodeTens, nodeCardinal, nodeMixed:
+		n := nd.children[0].value()
+		for _, c := range nd.children[1:] {
+			n = add(numbers{n, c.value()}, 0)[0]
+		}
+		return n
+	case nodeScale, nodeFraction:
+		return multiply(numbers{nd.children[0].value(), nd.children[1].value()}, 0)[0]
+	case nodeYear:
+		return yearOrDone(numbers{nd.children[0].value(), nd.children[1].value()}, 0)[0]
+	case nodeOrdinal:
+		ns := numbers{nd.children[0].value(), nd.children[1].value()}
+		switch {
+		case ns[1].typ != numBigOrdinal:
+			return add(ns, 0)[0]
+		case ns[0].typ == numTens:
+			return multiply(ns, 0)[0]
+		}
+		return combine(ns, 0)[0]
+	case nodePercent:
+		return percent(numbers{nd.children[0].value(), nd.children[1].value()}, 0)[0]
+	}
+	panic("numwords: unknown node kind")
+}
+
+// grammar is a recursive descent parser of number phrases. It also records
+// the first number that breaks the grammar, which is rejected in strict mode.
+type grammar struct {
+	ns numbers
+	i  int
+
+	bad    int    // the index of the first malformed number
+	reason string // why the number is malformed, empty if none are
+}
+
+// ParseGrammar parses the numbers into the parse trees of their phrases
+func parseGrammar(ns numbers) []*node {
+	g := &grammar{ns: ns}
+	return g.phrases()
+}
+
+func (g *grammar) phrases() (out []*node) {
+	for g.i < len(g.ns) {
+		start := g.i
+		var nd *node
+		if len(out) > 0 && isYearPair(g.ns[start-1].typ, g.peek(0)) && g.peek(1) != numSingle {
+			// as with yearOrDone, the second number of a pair that is not a year
+			// is left as it is (eg, "eleven zero thirds" => 11 0 1/3)
+			nd = g.percent(g.next())
+		} else {
+			nd = g.phrase()
+		}
+
+		switch {
+		case nd == nil:
+			g.malformed(start, "unexpected and")
+			continue
+		case len(out) > 0 && isYearPart(g.ns[start-1].typ) && isYearPart(g.ns[start].typ):
+			g.malformed(start, "not a year")
+		case len(out) > 0:
+			g.malformed(start, "unexpected number")
+		}
+
+		nd.start, nd.end = start, g.i
+		out = append(out, nd)
+	}
+	return
+}
+
+// Malformed records the number at index i as malformed, unless an earlier
+// number already is.
+func (g *grammar) malformed(i int, reason string) {
+	if g.reason == "" {
+		g.bad, g.reason = i, reason
+	}
+}
+
+// Values evaluates the parse trees of the phrases
+func values(trees []*node) numbers {
+	out := make(numbers, len(trees))
+	for i, nd := range trees {
+		out[i] = nd.value()
+	}
+	return out
+}
+
+// Evaluate converts the numbers to their minimal form with the parser's engine
+func (p *Parser) evaluate(ns numbers) numbers {
+	if p.engine == EngineGrammar {
+		return values(parseGrammar(ns))
+	}
+	return p.rules.reduce(ns)
+}
+
+// ReduceSpans converts the numbers to their minimal form with the parser's
+// engine, merging the spans of the numbers as they are combined.
+func (p *Parser) reduceSpans(ns numbers, spans []Span) (numbers, []Span) {
+	if p.engine != EngineGrammar {
+		return p.rules.trace(ns, spans, nil)
+	}
+
+	trees := parseGrammar(ns)
+	out := make([]Span, len(trees))
+	for i, nd := range trees {
+		out[i] = spans[nd.start]
+		for _, sp := range spans[nd.start+1 : nd.end] {
+			out[i] = out[i].union(sp)
+		}
+	}
+	return values(trees), out
+}
+
+// Peek returns the type of the number offset from the current one, or numDone
+// if there is none.
+func (g *grammar) peek(offset int) numberType {
+	if i := g.i + offset; i >= 0 && i < len(g.ns) {
+		return g.ns[i].typ
+	}
+	return numDone
+}
+
+// Next consumes the current number, returning it as a node
+func (g *grammar) next() *node {
+	nd := &node{kind: nodeNumber, n: g.ns[g.i]}
+	g.i++
+	return nd
+}
+
+func (g *grammar) phrase() *node {
+	if nd := g.year(); nd != nil {
+		return nd
+	}
+
+	if nd := g.quantity(); nd != nil {
+		return g.percent(nd)
+	}
+
+	if nd := g.next(); nd.n.typ != numAnd {
+		return nd
+	}
+	return nil
+}
+
+// Percent reads the percent sign following nd, if there is one
+func (g *grammar) percent(nd *node) *node {
+	if g.peek(0) == numPercentSign {
+		return &node{kind: nodePercent, children: []*node{nd, g.next()}}
+	}
+	return nd
+}
+
+func (g *grammar) year() *node {
+	if !isYearPair(g.peek(0), g.peek(1)) {
+		return nil
+	}
+
+	hi, lo := g.ns[g.i].numerator, g.ns[g.i+1].numerator
+	if hi <= 10 || hi > 20 || lo < 10 || lo >= 100 {
+		return nil
+	}
+
+	start := g.i
+	first := g.next()
+	second := g.small()
+	if g.peek(0) == numBig || (second.kind == nodeTens && second.value().numerator >= 100) {
+		g.i = start
+		return nil
+	}
+
+	return &node{kind: nodeYear, children: []*node{first, second}}
+}
+
+func (g *grammar) quantity() *node {
+	switch g.peek(0) {
+	case numFraction, numSlashFraction:
+		return g.next()
+	}
+
+	if c := g.cardinal(); c != nil {
+		return g.tail(c)
+	}
+	return nil
+}
+
+func (g *grammar) cardinal() *node {
+	var parts []*node
+	afterBig, afterAnd := false, 0
+
+loop:
+	for g.i < len(g.ns) {
+		switch typ := g.peek(0); {
+		case typ == numBig:
+			parts = g.scale(parts, afterAnd)
+			afterBig = true
+		case isSmall(typ) && (len(parts) == 0 || afterBig):
+			parts = append(parts, g.small())
+			afterBig = false
+		case typ == numAnd && afterBig && (isSmall(g.peek(1)) || g.peek(1) == numBig):
+			// "and" within a number (eg, "one hundred and five"), but not
+			// before a fraction (eg, "one hundred and a half")
+			start := g.i
+			g.i++
+			if g.fraction() != nil {
+				g.i = start
+				break loop
+			}
+			afterAnd = len(parts)
+		default:
+			break loop
+		}
+	}
+
+	switch len(parts) {
+	case 0:
+		return nil
+	case 1:
+		return parts[0]
+	}
+	return &node{kind: nodeCardinal, children: parts}
+}
+
+// Scale multiplies the trailing parts that are no larger than the multiplier
+// by it (eg, "two hundred five" "thousand" => 205000). A dozen multiplies all
+// of the parts from afterAnd, the first after any "and" (eg, "one hundred"
+// "dozen" => 1200, but "one hundred and" "two" "dozen" => 124). The multiplier
+// is malformed if it is repeated (eg, "hundred hundred") or out of order (eg,
+// "million thousand"), such that it multiplies nothing after other numbers,
+// multiplies a number of a thousand or more, or follows a part with the same
+// or a smaller scale.
+func (g *grammar) scale(parts []*node, afterAnd int) []*node {
+	idx := g.i
+	big := g.next()
+	m := big.n.numerator
+
+	i := len(parts)
+	for i > 0 && parts[i-1].value().numerator <= m {
+		i--
+	}
+	if isDozen(big.n) {
+		i = afterAnd
+	}
+
+	// the scales of the parts before must be larger (eg, not "two thousand
+	// three thousand")
+	for _, nd := range parts[:i] {
+		if pm := nd.multiplier(); pm == m {
+			g.malformed(idx, "repeated scale")
+		} else if pm != 0 && pm < m {
+			g.malformed(idx, "scale out of order")
+		}
+	}
+
+	if i == len(parts) {
+		if len(parts) > 0 {
+			g.malformedScale(idx, m, parts)
+		}
+		return append(parts, big)
+	}
+
+	left := parts[i]
+	if len(parts)-i > 1 {
+		left = &node{kind: nodeCardinal, children: append([]*node(nil), parts[i:]...)}
+	}
+
+	limit := m
+	if limit > 1000 {
+		limit = 1000
+	}
+	if left.value().numerator >= limit && !isDozen(big.n) {
+		g.malformedScale(idx, m, parts[i:])
+	}
+
+	return append(parts[:i], &node{kind: nodeScale, children: []*node{left, big}})
+}
+
+// MalformedScale records the multiplier m at index i as repeated if it is
+// already in the parts, or out of order if not.
+func (g *grammar) malformedScale(i, m int, parts []*node) {
+	for _, nd := range parts {
+		if nd.hasScale(m) {
+			g.malformed(i, "repeated scale")
+			return
+		}
+	}
+	g.malformed(i, "scale out of order")
+}
+
+func (g *grammar) small() *node {
+	nd := g.next()
+	if nd.n.typ == numTens && g.peek(0) == numSingle {
+		return &node{kind: nodeTens, children: []*node{nd, g.next()}}
+	}
+	return nd
+}
+
+func (g *grammar) tail(c *node) *node {
+	switch g.peek(0) {
+	case numFraction:
+		return &node{kind: nodeFraction, children: []*node{c, g.next()}}
+	case numSlashFraction:
+		return &node{kind: nodeMixed, children: []*node{c, g.next()}}
+	case numAnd:
+		start := g.i
+		g.i++
+		if f := g.fraction(); f != nil {
+			return &node{kind: nodeMixed, children: []*node{c, f}}
+		}
+		if c.value().typ == numBig && isOrdinal(g.peek(0)) {
+			return g.ordinal(c)
+		}
+		g.i = start
+	default:
+		if isOrdinal(g.peek(0)) {
+			return g.ordinal(c)
+		}
+	}
+	return c
+}
+
+// Fraction reads a fraction following "and", restoring the position if there
+// is none.
+func (g *grammar) fraction() *node {
+	switch g.peek(0) {
+	case numFraction, numSlashFraction:
+		return g.next()
+	}
+
+	start := g.i
+	c := g.cardinal()
+	switch {
+	case c == nil:
+	case g.peek(0) == numFraction:
+		return &node{kind: nodeFraction, children: []*node{c, g.next()}}
+	case isOne(c) && isOrdinal(g.peek(0)):
+		return g.ordinal(c)
+	}
+
+	g.i = start
+	return nil
+}
+
+// Ordinal reads the ordinal following c, which is either a fraction after one
+// (eg, "one fifth") or part of the same ordinal number (eg, "twenty first").
+// If neither, the ordinal is left unread and c is returned. As with the rules,
+// a teen ordinal does not follow a multiplier (eg, "five hundred eleventh" =>
+// 500 11th).
+func (g *grammar) ordinal(c *node) *node {
+	o := g.ns[g.i]
+
+	if isOne(c) {
+		g.i++
+		frac := number{1, o.numerator, numFraction, false}
+		return &node{kind: nodeFraction, children: []*node{c, {kind: nodeNumber, n: frac}}}
+	}
+
+	switch typ := c.value().typ; {
+	case typ == numBig && o.typ != numDirectOrdinal,
+		typ == numTens && (o.typ == numSingleOrdinal || o.typ == numBigOrdinal),
+		typ <= numSingle && o.typ == numBigOrdinal:
+		return &node{kind: nodeOrdinal, children: []*node{c, g.next()}}
+	}
+
+	return c
+}
+
+// IsOne returns true if the node is the single word one (or "a")
+func isOne(nd *node) bool {
+	return nd.kind == nodeNumber && nd.n.numerator == 1 && nd.n.denominator == 1 &&
+		(nd.n.typ == numDirect || nd.n.typ == numSingle)
+}
+
+func isSmall(typ numberType) bool {
+	return typ == numDirect || typ == numSingle || typ == numTens
+}
+
+func isYearPart(typ numberType) bool {
+	return typ == numDirect || typ == numTens
+}
+
+// IsYearPair returns true if the types are a pair read by yearOrDone
+func isYearPair(a, b numberType) bool {
+	return a == numDirect && (b == numDirect || b == numTens) ||
+		a == numTens && b == numDirect
+}
+
+func isOrdinal(typ numberType) bool {
+	return typ >= numDirectOrdinal && typ <= numBigOrdinal
+}
@@ -0,0 +1,128 @@
+package numwords
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrammar_AgreesWithPatterns(t *testing.T) {
+	t.Parallel()
+
+	g := New(WithEngine(EngineGrammar))
+
+	for _, test := range parseStringTests {
+		assert.Equal(t, test.out, g.ParseString(test.in), test.in)
+	}
+
+	for _, test := range parseFloatTests {
+		f, err := g.ParseFloat(test.in)
+		if assert.NoError(t, err) {
+			assert.Equal(t, test.out, f, test.in)
+		}
+	}
+
+	for _, test := range parseIntTests {
+		i, err := g.ParseInt(test.in)
+		if assert.NoError(t, err) {
+			assert.Equal(t, test.out, i, test.in)
+		}
+	}
+}
+
+func TestGrammar_AgreesWithRules(t *testing.T) {
+	t.Parallel()
+
+	pt := New(WithPercentages(PercentValue))
+	g := New(WithPercentages(PercentValue), WithEngine(EngineGrammar))
+
+	for _, r := range defaultRules {
+		for _, example := range strings.Split(r.Description, " || ") {
+			in := strings.Split(example, " => ")[0]
+			assert.Equal(t, pt.ParseString(in), g.ParseString(in), "%s: %s", r.Pattern, in)
+		}
+	}
+}
+
+func TestGrammar_AgreesOnEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	pt := New()
+	g := New(WithEngine(EngineGrammar))
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"five hundred eleventh", "500 11th"},
+		{"one hundred twelfth", "100 12th"},
+		{"two thousand tenth", "2000 10th"},
+		{"five hundred twenty first", "521st"},
+		{"one hundred hundredth", "10000th"},
+		{"zero thirds", "0"},
+		{"twenty halves", "10"},
+		{"eleven zero thirds", "11 0 0.333333"},
+		{"first eleven zero thirds", "1st 11 0 0.333333"},
+		{"ten twenty five", "10 25"},
+		{"ten twenty thirds", "10 20 0.333333"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, pt.ParseString(test.in), "patterns: %s", test.in)
+		assert.Equal(t, test.out, g.ParseString(test.in), "grammar: %s", test.in)
+	}
+}
+
+func TestGrammar_Trees(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in    string
+		trees []string
+	}{
+		{"twenty five", []string{"(tens 20 5)"}},
+		{"two hundred five thousand", []string{"(scale (cardinal (scale 2 100) 5) 1000)"}},
+		{"one thousand and four", []string{"(cardinal (scale 1 1000) 4)"}},
+		{"nineteen eighty eight", []string{"(year 19 (tens 80 8))"}},
+		{"three quarters", []string{"(fraction 3 0.25)"}},
+		{"one fifth", []string{"(fraction 1 0.2)"}},
+		{"twenty first", []string{"(ordinal 20 1st)"}},
+		{"two and a half", []string{"(mixed 2 (fraction 1 0.5))"}},
+		{"2 1/2", []string{"(mixed 2 0.5)"}},
+		{"five percent", []string{"(percent 5 %)"}},
+		{"two three", []string{"2", "3"}},
+		{"three and two", []string{"3", "2"}},
+	}
+
+	p := New(WithEngine(EngineGrammar), WithPercentages(PercentValue), WithAndPolicy(AndAlways), WithFractionFormat(FractionDecimal))
+	for _, test := range tests {
+		traces := p.Explain(test.in)
+		if assert.Len(t, traces, 1, test.in) {
+			assert.Equal(t, test.trees, traces[0].Trees, test.in)
+			assert.Empty(t, traces[0].Steps, test.in)
+		}
+	}
+}
+
+func TestGrammar_Numbers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"two hundred five thousand", "205000"},
+		{"seven million four hundred and fifty five thousand and eighty nine", "7455089"},
+		{"six hundred thirty seven million nine hundred seventy nine thousand nine hundred forty seven", "637979947"},
+		{"one hundred seventy million six hundred twenty five thousand three hundred fifty sixth", "170625356th"},
+		{"nine hundred and eleven thousand and eighty one and a half", "911081.5"},
+		{"five hundred eleventh", "500 11th"},
+		{"ten eighty percent", "10 80%"},
+	}
+
+	p := New(WithEngine(EngineGrammar), WithPercentages(PercentValue))
+	for _, test := range tests {
+		assert.Equal(t, test.out, p.ParseString(test.in), test.in)
+	}
+}
@@ -0,0 +1,339 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// Money is an amount of money read from text
+type Money struct {
+	// Amount is the exact value of the money in its major unit (eg, dollars).
+	Amount *big.Rat
+
+	// Currency is the ISO 4217 code of the currency (eg, "USD"). It is empty
+	// if the currency could not be determined, such as in "two grand".
+	Currency string
+}
+
+// currency describes how amounts of a currency are written
+type currency struct {
+	symbol   string
+	decimals int
+}
+
+// currencies are the currencies that can be read, keyed by ISO 4217 code
+var currencies = map[string]currency{
+	"USD": {"$", 2},
+	"EUR": {"€", 2},
+	"GBP": {"£", 2},
+	"JPY": {"¥", 0},
+	"CHF": {"", 2},
+	"CNY": {"", 2},
+	"INR": {"₹", 2},
+}
+
+// currencySymbols map symbols written before or after an amount to their
+// currency codes
+var currencySymbols = map[string]string{
+	"$": "USD",
+	"€": "EUR",
+	"£": "GBP",
+	"¥": "JPY",
+	"₹": "INR",
+}
+
+// currencyWord is a word naming a currency. Minor units (eg, cents) are worth
+// one hundredth of the major unit.
+type currencyWord struct {
+	code  string
+	minor bool
+}
+
+// currencyWords map the case-insensitive words for each currency to their codes
+var currencyWords = map[string]currencyWord{
+	"dollar":    {"USD", false},
+	"dollars":   {"USD", false},
+	"buck":      {"USD", false},
+	"bucks":     {"USD", false},
+	"euro":      {"EUR", false},
+	"euros":     {"EUR", false},
+	"pound":     {"GBP", false},
+	"pounds":    {"GBP", false},
+	"quid":      {"GBP", false},
+	"sterling":  {"GBP", false},
+	"yen":       {"JPY", false},
+	"franc":     {"CHF", false},
+	"francs":    {"CHF", false},
+	"yuan":      {"CNY", false},
+	"renminbi":  {"CNY", false},
+	"rupee":     {"INR", false},
+	"rupees":    {"INR", false},
+	"cent":      {"USD", true},
+	"cents":     {"USD", true},
+	"penny":     {"GBP", true},
+	"pennies":   {"GBP", true},
+	"pence":     {"GBP", true},
+	"eurocent":  {"EUR", true},
+	"eurocents": {"EUR", true},
+}
+
+// moneyMultipliers are slang words that multiply the amount before them
+var moneyMultipliers = map[string]int64{
+	"grand": 1000,
+	"k":     1000,
+}
+
+// moneyMatch is an amount of money found in a set of words
+type moneyMatch struct {
+	start, end int     // the range of words making up the amount
+	lead       numbers // numbers preceding the amount in the same phrase
+	money      Money
+}
+
+// String writes the money with its currency symbol or code (eg, "$3.50" or
+// "5.00 CHF"). Amounts with no currency are written as plain numbers.
+func (m Money) String() string {
+	c, ok := currencies[m.Currency]
+	if !ok {
+		if m.Amount.IsInt() {
+			return m.Amount.Num().String()
+		}
+		return m.Amount.FloatString(2)
+	}
+
+	s := m.Amount.FloatString(c.decimals)
+	if c.symbol == "" {
+		return s + " " + m.Currency
+	}
+
+	if strings.HasPrefix(s, "-") {
+		return "-" + c.symbol + s[1:]
+	}
+	return c.symbol + s
+}
+
+// ExtractMoney finds all amounts of money in the text string (eg, "three
+// dollars and fifty cents", "five quid", "two grand" or "$3.50").
+func ExtractMoney(s string) []Money {
+	return defaultParser.ExtractMoney(s)
+}
+
+// ExtractMoney finds all amounts of money in the text string (eg, "three
+// dollars and fifty cents", "five quid", "two grand" or "$3.50").
+func (p *Parser) ExtractMoney(s string) []Money {
+	matches := p.matchAllMoney(splitMoney(p.tokenize(s)))
+
+	out := make([]Money, len(matches))
+	for i, m := range matches {
+		out[i] = m.money
+	}
+	return out
+}
+
+// ParseMoneyStrings performs the same actions as ParseStrings, additionally
+// writing any amounts of money in a normalized form (eg, "$3.50").
+func (p *Parser) parseMoneyStrings(toks []token) []string {
+	toks = splitMoney(toks)
+	out := make([]string, 0, len(toks))
+
+	last := 0
+	for _, m := range p.matchAllMoney(toks) {
+		out = append(out, p.parseStrings(toks[last:m.start])...)
+
+		words := append(m.lead.strings(), m.money.String())
+		words[0] = toks[m.start].pre + words[0]
+		words[len(words)-1] += toks[m.end-1].post
+		out = append(out, words...)
+
+		last = m.end
+	}
+
+	return append(out, p.parseStrings(toks[last:])...)
+}
+
+// MatchAllMoney finds all non-overlapping amounts of money in the tokens
+func (p *Parser) matchAllMoney(toks []token) (out []moneyMatch) {
+	in := cores(toks)
+	for i := 0; i < len(in); {
+		if m, ok := p.matchMoney(toks, in, i); ok {
+			out = append(out, m)
+			i = m.end
+		} else {
+			i++
+		}
+	}
+	return
+}
+
+// MatchMoney attempts to read an amount of money starting at the ith word of
+// the tokens, whose cores are in.
+// The amount is made up of an optional currency symbol, a number, an optional
+// multiplier (eg, "grand"), and a currency word (eg, "dollars"), which may be
+// followed by an amount in its minor unit (eg, "and fifty cents"). At least
+// one of the symbol, multiplier or currency word must be present.
+func (p *Parser) matchMoney(toks []token, in []string, i int) (m moneyMatch, ok bool) {
+	m.start = i
+
+	j := i
+	if m.money.Currency, ok = currencySymbols[in[j]]; ok {
+		j++
+	}
+
+	ns, j := p.readNumbers(in, j, nil)
+	if len(ns) == 0 {
+		return m, false
+	}
+
+	last := len(ns) - 1
+	amount, lead := ns[last], ns[:last]
+	if amount.ordinal || amount.typ == numPercent || amount.overflowed() {
+		return m, false
+	}
+
+	m.lead = lead
+	m.money.Amount = big.NewRat(int64(amount.numerator), int64(amount.denominator))
+	found := m.money.Currency != ""
+
+	if j < len(in) {
+		if mult, ok := moneyMultipliers[strings.ToLower(in[j])]; ok {
+			m.money.Amount.Mul(m.money.Amount, big.NewRat(mult, 1))
+			found = true
+			j++
+		}
+	}
+
+	if j < len(in) {
+		if cur, ok := currencySymbols[in[j]]; ok && (m.money.Currency == "" || m.money.Currency == cur) {
+			m.money.Currency = cur
+			found = true
+			j++
+		} else if w, ok := currencyWords[strings.ToLower(in[j])]; ok {
+			if m.money.Currency == "" {
+				m.money.Currency = w.code
+			}
+			found = true
+			j++
+
+			if w.minor {
+				m.money.Amount.Quo(m.money.Amount, big.NewRat(100, 1))
+			} else {
+				j = p.readMinorMoney(toks, in, j, &m.money)
+			}
+		}
+	}
+
+	m.end = j
+	return m, found
+}
+
+// ReadMinorMoney reads an amount in a minor unit of currency following the
+// major unit, such as "and fifty cents" or "fifty" in "five pounds fifty". The
+// amount is added to the money, returning the index of the next word. Without
+// a minor unit, the amount must be spelled out, follow the major unit directly
+// and end the phrase (eg, not "five dollars 3 times").
+func (p *Parser) readMinorMoney(toks []token, in []string, i int, m *Money) int {
+	j, and := i, false
+	if j < len(in) && strings.EqualFold(in[j], "and") {
+		j, and = j+1, true
+	}
+
+	k := j
+	ns, j := p.readNumbers(in, j, nil)
+	if len(ns) != 1 || ns[0].denominator != 1 || ns[0].ordinal || ns[0].numerator < 1 || ns[0].numerator > 99 {
+		return i
+	}
+
+	if j < len(in) {
+		if w, ok := currencyWords[strings.ToLower(in[j])]; ok && w.minor {
+			m.Amount.Add(m.Amount, big.NewRat(int64(ns[0].numerator), 100))
+			return j + 1
+		}
+	}
+
+	if and || toks[i-1].post != "" || !spelledOut(in[k:j]) || (j < len(in) && toks[j-1].post == "" && toks[j].pre == "") {
+		return i
+	}
+
+	m.Amount.Add(m.Amount, big.NewRat(int64(ns[0].numerator), 100))
+	return j
+}
+
+// ReadNumbers reads the longest run of numbers starting at the ith word,
+// returning them reduced along with the index of the first word after them. If
+// stop is not nil, the run also ends before any word after the first for which
+// it returns true.
+func (p *Parser) readNumbers(in []string, i int, stop func(string) bool) (numbers, int) {
+	buf, j := p.readRun(in, i, stop)
+	return p.evaluate(buf), j
+}
+
+// ReadRun reads the run of numbers like readNumbers, without reducing them.
+func (p *Parser) readRun(in []string, i int, stop func(string) bool) (numbers, int) {
+	buf := numbers{}
+
+	ok := false
+	for ; i < len(in); i++ {
+		if len(buf) > 0 && stop != nil && stop(in[i]) {
+			break
+		}
+		if buf, ok = p.readIntoBuffer(i, in, buf); !ok {
+			break
+		}
+	}
+
+	return buf, i
+}
+
+// SplitMoney splits currency symbols and the "k" multiplier from numbers written
+// with digits (eg, "$5k" => "$" "5" "k").
+func splitMoney(in []token) []token {
+	out := make([]token, 0, len(in))
+
+	for _, t := range in {
+		var pre, post []string
+		s := t.core
+
+		if r, size := utf8.DecodeRuneInString(s); size < len(s) {
+			if _, ok := currencySymbols[string(r)]; ok {
+				pre, s = append(pre, string(r)), s[size:]
+			}
+		}
+
+		if r, size := utf8.DecodeLastRuneInString(s); size < len(s) {
+			if _, ok := currencySymbols[string(r)]; ok {
+				post, s = append(post, string(r)), s[:len(s)-size]
+			}
+		}
+
+		if len(s) > 1 && (s[len(s)-1] == 'k' || s[len(s)-1] == 'K') && isDigit(rune(s[len(s)-2])) {
+			post, s = append([]string{"k"}, post...), s[:len(s)-1]
+		}
+
+		if (len(pre) == 0 && len(post) == 0) || !isDigit([]rune(s)[0]) {
+			out = append(out, t)
+			continue
+		}
+
+		words := append(append(pre, s), post...)
+		first := len(out)
+		for _, w := range words {
+			out = append(out, token{core: w})
+		}
+		out[first].pre = t.pre
+		out[len(out)-1].post = t.post
+	}
+
+	return out
+}
+
+// SpelledOut returns true if all of the words are in the dictionary, rather
+// than written with digits.
+func spelledOut(words []string) bool {
+	for _, w := range words {
+		if _, ok := lookupNumber(w); !ok {
+			return false
+		}
+	}
+	return true
+}
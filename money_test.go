@@ -0,0 +1,106 @@
+package numwords
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount   *big.Rat
+		currency string
+		expected string
+	}{
+		{big.NewRat(7, 2), "USD", "$3.50"},
+		{big.NewRat(5, 1), "GBP", "£5.00"},
+		{big.NewRat(-5, 1), "EUR", "-€5.00"},
+		{big.NewRat(500, 1), "JPY", "¥500"},
+		{big.NewRat(5, 1), "CHF", "5.00 CHF"},
+		{big.NewRat(2000, 1), "", "2000"},
+		{big.NewRat(5, 2), "", "2.50"},
+	}
+
+	for _, test := range tests {
+		m := Money{test.amount, test.currency}
+		assert.Equal(t, test.expected, m.String())
+	}
+}
+
+func TestMoney_ExtractMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"three dollars and fifty cents", []string{"$3.50"}},
+		{"five quid", []string{"£5.00"}},
+		{"two grand", []string{"2000"}},
+		{"two grand in euros", []string{"2000"}},
+		{"a hundred bucks", []string{"$100.00"}},
+		{"fifty cents", []string{"$0.50"}},
+		{"twenty pence", []string{"£0.20"}},
+		{"five pounds fifty", []string{"£5.50"}},
+		{"five pounds fifty, please", []string{"£5.50"}},
+		{"five dollars 3 times", []string{"$5.00"}},
+		{"five dollars three times", []string{"$5.00"}},
+		{"five pounds 50", []string{"£5.00"}},
+		{"five pounds, fifty", []string{"£5.00"}},
+		{"five dollars 3 cents", []string{"$5.03"}},
+		{"ten euros and five cents", []string{"€10.05"}},
+		{"$3.50", []string{"$3.50"}},
+		{"$ 3.50", []string{"$3.50"}},
+		{"$5k", []string{"$5000.00"}},
+		{"5€", []string{"€5.00"}},
+		{"£2 million", []string{"£2000000.00"}},
+		{"¥500", []string{"¥500"}},
+		{"it cost 5 dollars and 3 apples", []string{"$5.00"}},
+		{"one dollar, then two euros", []string{"$1.00", "€2.00"}},
+		{"three apples", nil},
+		{"second dollar", nil},
+		{"dollars", nil},
+	}
+
+	for _, test := range tests {
+		var actual []string
+		for _, m := range ExtractMoney(test.in) {
+			actual = append(actual, m.String())
+		}
+		assert.Equal(t, test.expected, actual, test.in)
+	}
+
+	m := ExtractMoney("three dollars and fifty cents")
+	if assert.Len(t, m, 1) {
+		assert.Equal(t, "USD", m[0].Currency)
+		assert.Equal(t, big.NewRat(7, 2), m[0].Amount)
+	}
+}
+
+func TestMoney_SplitMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       []string
+		expected []string
+	}{
+		{[]string{"$5"}, []string{"$", "5"}},
+		{[]string{"$5k"}, []string{"$", "5", "k"}},
+		{[]string{"5k€"}, []string{"5", "k", "€"}},
+		{[]string{"5K"}, []string{"5", "k"}},
+		{[]string{"$"}, []string{"$"}},
+		{[]string{"$abc"}, []string{"$abc"}},
+		{[]string{"book"}, []string{"book"}},
+		{[]string{"a5k"}, []string{"a5k"}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, cores(splitMoney(splitTokens(test.in))), "%v", test.in)
+	}
+
+	toks := splitMoney(splitTokens([]string{"($5k),"}))
+	assert.Equal(t, []string{"($", "5", "k),"}, []string{toks[0].String(), toks[1].String(), toks[2].String()})
+}
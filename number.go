@@ -1,9 +1,10 @@
 package numwords
 
 import (
-	"math/big"
+	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 type number struct {
@@ -24,7 +25,90 @@ func (n number) Value() float64 {
 	return float64(n.numerator) / float64(n.denominator)
 }
 
+// Overflowed returns true if the number is too large to be represented
+// exactly, which is marked by a zero numerator and denominator.
+func (n number) overflowed() bool {
+	return n.denominator == 0
+}
+
+// Sum returns the numerator and denominator of the sum of the number values,
+// or zeros if it overflows.
+func sum(a, b number) (num, den int) {
+	x, ok1 := mulInt(a.numerator, b.denominator)
+	y, ok2 := mulInt(a.denominator, b.numerator)
+	num, ok3 := addInt(x, y)
+	den, ok4 := mulInt(a.denominator, b.denominator)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return 0, 0
+	}
+	return num, den
+}
+
+// Product returns the numerator and denominator of the product of the number
+// values, or zeros if it overflows.
+func product(a, b number) (num, den int) {
+	num, ok1 := mulInt(a.numerator, b.numerator)
+	den, ok2 := mulInt(a.denominator, b.denominator)
+	if !ok1 || !ok2 {
+		return 0, 0
+	}
+	return num, den
+}
+
+// AddInt adds the integers, returning false if the result overflows
+func addInt(a, b int) (int, bool) {
+	c := a + b
+	return c, (c > a) == (b > 0)
+}
+
+// MulInt multiplies the integers, returning false if the result overflows
+func mulInt(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	return c, c/b == a && !(a == -1 && b == math.MinInt) && !(b == -1 && a == math.MinInt)
+}
+
+// SlashString returns the representation of the number with any fractional
+// portion written with a slash (eg, "2 1/2").
+func (n number) slashString() string {
+	if n.ordinal || n.denominator == 1 || n.overflowed() {
+		return n.String()
+	}
+
+	if n.typ == numPercent {
+		n.typ = numFraction
+		return n.slashString() + "%"
+	}
+
+	num, den := n.numerator, n.denominator
+	if g := gcd(num, den); g > 1 {
+		num, den = num/g, den/g
+	}
+
+	sign := ""
+	if num < 0 {
+		sign, num = "-", -num
+	}
+
+	frac := strconv.Itoa(num%den) + "/" + strconv.Itoa(den)
+	switch {
+	case den == 1:
+		return sign + strconv.Itoa(num)
+	case num < den:
+		return sign + frac
+	default:
+		return sign + strconv.Itoa(num/den) + " " + frac
+	}
+}
+
 func (n number) String() string {
+	if n.typ == numPercent {
+		n.typ = numFraction
+		return n.String() + "%"
+	}
+
 	if n.ordinal {
 		suffix := "th"
 		if r := n.numerator % 10; r > 0 && r < 4 {
@@ -37,14 +121,23 @@ func (n number) String() string {
 
 	if n.denominator != 1 {
 		s := strconv.FormatFloat(n.Value(), 'f', 6, 64)
-		return strings.TrimRight(s, ".0")
+		return strings.TrimRight(strings.TrimRight(s, "0"), ".")
 	}
 
 	return strconv.Itoa(n.numerator)
 }
 
-func maybeNumeric(s string) (n number, ok bool) {
-	s = strings.Replace(s, ",", "", -1)
+// MaybeNumeric attempts to read a number written with digits, optionally
+// followed by an ordinal suffix (eg, "1,000" or "22nd"), or a Unicode numeral.
+func (p *Parser) maybeNumeric(s string) (n number, ok bool) {
+	if n, ok = p.maybeNumeral(s); ok {
+		return
+	}
+
+	if idx := strings.IndexAny(s, "/⁄"); idx >= 0 {
+		_, size := utf8.DecodeRuneInString(s[idx:])
+		return p.maybeSlashFraction(s[:idx], s[idx+size:])
+	}
 
 	ord := false
 	for _, suffix := range ordinals {
@@ -55,47 +148,64 @@ func maybeNumeric(s string) (n number, ok bool) {
 		}
 	}
 
-	if i, err := strconv.Atoi(s); err == nil {
-		ok = true
-		n.numerator = i
-		n.denominator = 1
-		n.ordinal = ord
-	} else if f, err := strconv.ParseFloat(s, 64); err == nil {
-		rat := big.NewRat(1, 1).SetFloat64(f)
-		if rat != nil {
-			ok = true
-			n.numerator = int(rat.Num().Int64())
-			n.denominator = int(rat.Denom().Int64())
-			n.typ = numFraction
-		}
-		return
-	} else {
+	if n, ok = p.format.parse(s); !ok || n.typ == numFraction {
 		return
 	}
 
-	if !ord {
-		switch {
-		case n.numerator < 10 && n.numerator > 0:
-			n.typ = numSingle
-		case n.numerator >= 20 && n.numerator < 100:
-			n.typ = numTens
-		case n.numerator >= 100:
-			n.typ = numBig
-		default:
-			n.typ = numDirect
-		}
-	} else {
+	n.ordinal = ord
+	n.typ = classify(n.numerator, ord)
+	return
+}
+
+// MaybeSlashFraction reads a fraction written with a slash (eg, "3/4") from its
+// numerator and denominator.
+func (p *Parser) maybeSlashFraction(num, den string) (n number, ok bool) {
+	a, ok := p.format.parse(num)
+	if !ok || a.typ == numFraction {
+		return n, false
+	}
+
+	b, ok := p.format.parse(den)
+	if !ok || b.typ == numFraction || b.numerator <= 0 {
+		return n, false
+	}
+
+	return number{a.numerator, b.numerator, numSlashFraction, false}, true
+}
+
+// Classify determines the type of an integer value based on its magnitude
+func classify(i int, ordinal bool) numberType {
+	if !ordinal {
 		switch {
-		case n.numerator < 10 && n.numerator > 0:
-			n.typ = numSingleOrdinal
-		case n.numerator >= 20 && n.numerator < 100:
-			n.typ = numTensOrdinal
-		case n.numerator >= 100:
-			n.typ = numBigOrdinal
+		case i < 10 && i > 0:
+			return numSingle
+		case i >= 20 && i < 100:
+			return numTens
+		case i >= 100:
+			return numBig
 		default:
-			n.typ = numDirectOrdinal
+			return numDirect
 		}
 	}
 
-	return
+	switch {
+	case i < 10 && i > 0:
+		return numSingleOrdinal
+	case i >= 20 && i < 100:
+		return numTensOrdinal
+	case i >= 100:
+		return numBigOrdinal
+	default:
+		return numDirectOrdinal
+	}
+}
+
+func gcd(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
 }
@@ -63,6 +63,34 @@ func TestNumber_String(t *testing.T) {
 	}
 }
 
+func TestNumber_SlashString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n        int
+		d        int
+		o        bool
+		expected string
+	}{
+		{1, 1, false, "1"},
+		{1, 2, false, "1/2"},
+		{2, 4, false, "1/2"},
+		{5, 2, false, "2 1/2"},
+		{-5, 2, false, "-2 1/2"},
+		{8, 4, false, "2"},
+		{2, 1, true, "2nd"},
+	}
+
+	for _, test := range tests {
+		n := number{
+			numerator:   test.n,
+			denominator: test.d,
+			ordinal:     test.o,
+		}
+		assert.Equal(t, test.expected, n.slashString(), "%+v", test)
+	}
+}
+
 func TestNumber_MaybeNumeric(t *testing.T) {
 	t.Parallel()
 
@@ -88,13 +116,59 @@ func TestNumber_MaybeNumeric(t *testing.T) {
 		{"222nd", float64(222), numBigOrdinal, true},
 
 		{"2,222,222", float64(2222222), numBig, true},
+		{"2,222,222nd", float64(2222222), numBigOrdinal, true},
+		{"2'222", float64(2222), numBig, true},
+		{"٣٤", float64(34), numTens, true},
+		{"३४", float64(34), numTens, true},
+		{"３", float64(3), numSingle, true},
+		{"３rd", float64(3), numSingleOrdinal, true},
+		{"１,０００", float64(1000), numBig, true},
+		{"٠.٥", float64(.5), numFraction, true},
+		{"2½", float64(2.5), numFraction, true},
+		{"Ⅻ", float64(12), numDirect, true},
+		{"3/4", float64(.75), numSlashFraction, true},
+		{"07/10", float64(.7), numSlashFraction, true},
+		{"1⁄2", float64(.5), numSlashFraction, true},
+		{in: "1/0", ok: false},
+		{in: "1/2/3", ok: false},
+		{in: "0.5/2", ok: false},
+		{in: "and/or", ok: false},
+
+		{in: "2,22", ok: false},
+		{in: "22,22,222", ok: false},
 	}
 
 	for _, test := range tests {
-		n, ok := maybeNumeric(test.in)
+		n, ok := defaultParser.maybeNumeric(test.in)
 		if assert.Equal(t, test.ok, ok) && ok {
 			assert.Equal(t, test.val, n.Value())
 			assert.Equal(t, test.typ, n.typ)
 		}
 	}
 }
+
+func TestNumber_Overflow(t *testing.T) {
+	t.Parallel()
+
+	big := number{1000000000000000000, 1, numBig, false}
+	small := number{5, 1, numSingle, false}
+
+	num, den := product(small, big)
+	assert.Equal(t, 5000000000000000000, num)
+	assert.Equal(t, 1, den)
+
+	num, den = product(number{10, 1, numTens, false}, big)
+	assert.Equal(t, 0, num)
+	assert.Equal(t, 0, den)
+
+	num, den = sum(number{9000000000000000000, 1, numBig, false}, big)
+	assert.Equal(t, 0, num)
+	assert.Equal(t, 0, den)
+
+	n := number{}
+	assert.True(t, n.overflowed())
+	num, den = sum(n, small)
+	assert.True(t, number{num, den, numBig, false}.overflowed(), "overflow is kept")
+	num, den = product(small, n)
+	assert.True(t, number{num, den, numBig, false}.overflowed(), "overflow is kept")
+}
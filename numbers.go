@@ -17,6 +17,10 @@ var (
 	// ErrNonNumber is returned if ParseInt or ParseFloat encounters a non-number in
 	// the input string.
 	ErrNonNumber = errors.New("the string contains a non-number")
+
+	// ErrOutOfRange is returned if a number is too large to be represented
+	// exactly, or by ParseAs if it does not fit in the requested type.
+	ErrOutOfRange = errors.New("the number is out of range")
 )
 
 type numbers []number
@@ -46,42 +50,71 @@ func (ns numbers) String() string {
 // Float returns a single value for the post-reduced numbers. If the length of
 // numbers is not one, an error is returned instead
 func (ns numbers) Float() (float64, error) {
+	n, err := ns.single()
+	if err != nil {
+		return -1, err
+	}
+
+	return n.Value(), nil
+}
+
+// Single returns the only number of the post-reduced numbers. If the length of
+// numbers is not one, an error is returned instead
+func (ns numbers) single() (number, error) {
 	if len(ns) == 0 {
-		return -1, ErrNoNumbers
+		return number{}, ErrNoNumbers
 	} else if len(ns) > 1 {
-		return -1, ErrManyNumbers
+		return number{}, ErrManyNumbers
+	} else if ns[0].overflowed() {
+		return number{}, ErrOutOfRange
 	}
 
-	return ns[0].Value(), nil
+	return ns[0], nil
 }
 
-// Int returns a single integer value for the post-reduced numbers, similar to
-// number.Float.
-func (ns numbers) Int() (int, error) {
-	out, err := ns.Float()
-	return int(out), err
+// Ratios converts any percentages to their equivalent ratios (eg, 50% => 0.5).
+func (ns numbers) ratios() numbers {
+	for i := range ns {
+		if ns[i].typ == numPercent {
+			ns[i].denominator *= 100
+			ns[i].typ = numFraction
+		}
+	}
+	return ns
 }
 
-func (ns numbers) flush(s []string) []string {
-	if len(ns) > 0 {
-		s = append(s, reduce(ns).strings()...)
+// Flush reduces the numbers read from the words and appends their string
+// representations to s, formatting any fractions as configured. If any of the
+// numbers is too large to be represented, the words are appended unchanged.
+func (p *Parser) flush(words []string, ns numbers, s []string) []string {
+	if len(ns) == 0 {
+		return s
+	}
+
+	ns = p.reduce(words, ns)
+	for _, n := range ns {
+		if n.overflowed() {
+			return append(s, words...)
+		}
+	}
+
+	if p.fractions != FractionSlash {
+		return append(s, ns.strings()...)
+	}
+
+	for _, n := range ns {
+		s = append(s, n.slashString())
 	}
 	return s
 }
 
-// Reduce destructivley converts the numbers set to its minimal form based on
-// known numeric patterns. NB: the input slice will be modified significantly
-func reduce(ns numbers) numbers {
-	for found := true; found; {
-		found = false
-		pattern := ns.pattern()
-		for _, p := range patterns {
-			if idx := strings.LastIndex(pattern, p); idx >= 0 {
-				found = true
-				ns = patternHandlers[p](ns, idx)
-				break
-			}
-		}
+// FlushTokens flushes the numbers read from the run of tokens, reattaching the
+// punctuation before the first token and after the last.
+func (p *Parser) flushTokens(run []token, ns numbers, s []string) []string {
+	n := len(s)
+	if s = p.flush(cores(run), ns, s); len(s) > n {
+		s[n] = run[0].pre + s[n]
+		s[len(s)-1] += run[len(run)-1].post
 	}
-	return ns
+	return s
 }
@@ -16,6 +16,7 @@ func TestNumbers_Pattern(t *testing.T) {
 		{typ: numTens},
 		{typ: numBig},
 		{typ: numFraction},
+		{typ: numSlashFraction},
 		{typ: numDirectOrdinal},
 		{typ: numSingleOrdinal},
 		{typ: numTensOrdinal},
@@ -24,7 +25,7 @@ func TestNumbers_Pattern(t *testing.T) {
 		{typ: numDone + 1},
 	}
 
-	assert.Equal(t, "&dstbfDSTB__", ns.pattern())
+	assert.Equal(t, "&dstbf/DSTB__", ns.pattern())
 }
 
 func TestNumbers_Strings(t *testing.T) {
@@ -60,7 +61,7 @@ func TestNumbers_Reduce(t *testing.T) {
 		number{numerator: 100, denominator: 1, typ: numBig},
 	}
 
-	out := reduce(ns)
+	out := defaultRuleSet.reduce(ns)
 	assert.Len(t, out, 1)
 	assert.Equal(t, float64(1200), out[0].Value())
 	assert.Equal(t, numBig, out[0].typ)
@@ -85,25 +86,8 @@ func TestNumbers_Float(t *testing.T) {
 	ns = ns[:0]
 	_, err = ns.Float()
 	assert.Equal(t, ErrNoNumbers, err)
-}
-
-func TestNumbers_Int(t *testing.T) {
-	t.Parallel()
-
-	ns := numbers{
-		number{numerator: 3, denominator: 2},
-	}
-
-	out, err := ns.Int()
-	assert.NoError(t, err)
-	assert.Equal(t, 1, out)
-
-	ns = append(ns, number{})
-
-	_, err = ns.Int()
-	assert.Equal(t, ErrManyNumbers, err)
 
-	ns = ns[:0]
-	_, err = ns.Int()
-	assert.Equal(t, ErrNoNumbers, err)
+	ns = numbers{number{}}
+	_, err = ns.Float()
+	assert.Equal(t, ErrOutOfRange, err)
 }
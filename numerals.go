@@ -0,0 +1,106 @@
+package numwords
+
+import "unicode/utf8"
+
+// vulgarFractions maps the Unicode vulgar fraction characters to their values.
+// Like fractions written with a slash, they add to a preceding whole number.
+var vulgarFractions = map[rune]number{
+	'↉': {0, 3, numSlashFraction, false},
+	'⅒': {1, 10, numSlashFraction, false},
+	'⅑': {1, 9, numSlashFraction, false},
+	'⅛': {1, 8, numSlashFraction, false},
+	'⅐': {1, 7, numSlashFraction, false},
+	'⅙': {1, 6, numSlashFraction, false},
+	'⅕': {1, 5, numSlashFraction, false},
+	'¼': {1, 4, numSlashFraction, false},
+	'⅓': {1, 3, numSlashFraction, false},
+	'⅜': {3, 8, numSlashFraction, false},
+	'⅖': {2, 5, numSlashFraction, false},
+	'½': {1, 2, numSlashFraction, false},
+	'⅗': {3, 5, numSlashFraction, false},
+	'⅝': {5, 8, numSlashFraction, false},
+	'⅔': {2, 3, numSlashFraction, false},
+	'¾': {3, 4, numSlashFraction, false},
+	'⅘': {4, 5, numSlashFraction, false},
+	'⅚': {5, 6, numSlashFraction, false},
+	'⅞': {7, 8, numSlashFraction, false},
+}
+
+// numeralRanges describes runs of consecutive Unicode numerals (categories No
+// and Nl) that count up from the value first. Superscripts and subscripts are
+// excluded as they typically denote exponents and indices.
+var numeralRanges = []struct {
+	lo, hi rune
+	first  int
+}{
+	{'Ⅰ', 'Ⅻ', 1},  // roman numerals
+	{'ⅰ', 'ⅻ', 1},  // small roman numerals
+	{'①', '⑳', 1},  // circled
+	{'⑴', '⒇', 1},  // parenthesized
+	{'⒈', '⒛', 1},  // full stop
+	{'⓪', '⓪', 0},  // circled zero
+	{'⓫', '⓴', 11}, // negative circled
+	{'⓵', '⓾', 1},  // double circled
+	{'⓿', '⓿', 0},  // negative circled zero
+	{'❶', '❿', 1},  // dingbat negative circled
+	{'➀', '➉', 1},  // dingbat circled sans-serif
+	{'➊', '➓', 1},  // dingbat negative circled sans-serif
+	{'㉑', '㉟', 21}, // circled
+	{'㊱', '㊿', 36}, // circled
+}
+
+// romanNumerals maps the remaining single-character roman numerals
+var romanNumerals = map[rune]int{
+	'Ⅼ': 50, 'Ⅽ': 100, 'Ⅾ': 500, 'Ⅿ': 1000,
+	'ⅼ': 50, 'ⅽ': 100, 'ⅾ': 500, 'ⅿ': 1000,
+}
+
+// UnicodeNumeral returns the value of a single numeric code point that is not
+// a decimal digit, such as "½", "Ⅻ" or "①".
+func unicodeNumeral(r rune) (n number, ok bool) {
+	if n, ok = vulgarFractions[r]; ok {
+		return
+	}
+
+	i, ok := romanNumerals[r]
+	for _, rng := range numeralRanges {
+		if rng.lo <= r && r <= rng.hi {
+			i, ok = rng.first+int(r-rng.lo), true
+			break
+		}
+	}
+
+	if ok {
+		n = number{i, 1, classify(i, false), false}
+	}
+
+	return
+}
+
+// MaybeNumeral reads a Unicode numeral on its own, or a vulgar fraction
+// following a number written with digits (eg, "2½" => 2.5).
+func (p *Parser) maybeNumeral(s string) (n number, ok bool) {
+	r, size := utf8.DecodeLastRuneInString(s)
+	if size == len(s) {
+		return unicodeNumeral(r)
+	}
+
+	frac, ok := vulgarFractions[r]
+	if !ok {
+		return
+	}
+
+	whole, ok := p.format.parse(s[:len(s)-size])
+	if !ok || whole.typ == numFraction {
+		return n, false
+	}
+
+	n = frac
+	n.typ = numFraction
+	if whole.numerator < 0 {
+		n.numerator = -n.numerator
+	}
+	n.numerator += whole.numerator * n.denominator
+
+	return n, true
+}
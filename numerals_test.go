@@ -0,0 +1,68 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumerals_UnicodeNumeral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  rune
+		val float64
+		typ numberType
+		ok  bool
+	}{
+		{'½', 0.5, numSlashFraction, true},
+		{'¾', 0.75, numSlashFraction, true},
+		{'⅓', float64(1) / 3, numSlashFraction, true},
+		{'Ⅻ', 12, numDirect, true},
+		{'ⅳ', 4, numSingle, true},
+		{'Ⅿ', 1000, numBig, true},
+		{'①', 1, numSingle, true},
+		{'⑳', 20, numTens, true},
+		{'⓪', 0, numDirect, true},
+		{'㊿', 50, numTens, true},
+		{in: '²', ok: false},
+		{in: 'a', ok: false},
+	}
+
+	for _, test := range tests {
+		n, ok := unicodeNumeral(test.in)
+		if assert.Equal(t, test.ok, ok, "%q", test.in) && ok {
+			assert.Equal(t, test.val, n.Value(), "%q", test.in)
+			assert.Equal(t, test.typ, n.typ, "%q", test.in)
+		}
+	}
+}
+
+func TestNumerals_MaybeNumeral(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		val float64
+		typ numberType
+		ok  bool
+	}{
+		{"½", 0.5, numSlashFraction, true},
+		{"2½", 2.5, numFraction, true},
+		{"１¾", 1.75, numFraction, true},
+		{"-1½", -1.5, numFraction, true},
+		{"1,000½", 1000.5, numFraction, true},
+		{in: "0.5½", ok: false},
+		{in: "a½", ok: false},
+		{in: "2Ⅻ", ok: false},
+		{in: "12", ok: false},
+	}
+
+	for _, test := range tests {
+		n, ok := defaultParser.maybeNumeral(test.in)
+		if assert.Equal(t, test.ok, ok, test.in) && ok {
+			assert.Equal(t, test.val, n.Value(), test.in)
+			assert.Equal(t, test.typ, n.typ, test.in)
+		}
+	}
+}
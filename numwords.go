@@ -5,80 +5,278 @@
 // Source: https://github.com/rodaine/numwords
 package numwords
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
 
 // ParseFloat reads a text string and converts it to its float value. An error
 // is returned if the if the string cannot be resolved to a single float value.
 func ParseFloat(s string) (float64, error) {
-	in := explode(s)
-	buf := numbers{}
+	return defaultParser.ParseFloat(s)
+}
 
-	ok := false
-	for i := range in {
-		if buf, ok = readIntoBuffer(i, in, buf); !ok {
-			return -1, ErrNonNumber
-		}
+// ParseInt reads a text string and converts it to its integer value. An error
+// is returned if the if the string cannot be resolved to a single integer value.
+// Fractional portions of the number will be truncated.
+func ParseInt(s string) (int, error) {
+	return defaultParser.ParseInt(s)
+}
+
+// ParseString reads a text string and converts all numbers contained within to
+// their appropriate values. Integers are preserved exactly while floating point
+// numbers are limited to six decimal places. The rest of the string is preserved.
+func ParseString(s string) string {
+	return defaultParser.ParseString(s)
+}
+
+// ParseStrings performs the same actions as ParseString but operates on a pre-
+// sanitized and split string. This method is exposed for convenience if further
+// processing of the string is required.
+func ParseStrings(in []string) []string {
+	return defaultParser.ParseStrings(in)
+}
+
+// ParseFloat reads a text string and converts it to its float value. An error
+// is returned if the if the string cannot be resolved to a single float value.
+// See WithStrict to reject malformed numbers and WithLenient to ignore words
+// that are not numbers.
+func (p *Parser) ParseFloat(s string) (float64, error) {
+	ns, err := p.parse(s)
+	if err != nil {
+		return -1, err
 	}
 
-	return reduce(buf).Float()
+	return p.ratios(ns).Float()
 }
 
 // ParseInt reads a text string and converts it to its integer value. An error
 // is returned if the if the string cannot be resolved to a single integer value.
-// Fractional portions of the number will be truncated.
-func ParseInt(s string) (int, error) {
-	in := explode(s)
+// Fractional portions of the number will be truncated, unless another mode is
+// set with WithRounding. See WithStrict to reject malformed numbers and
+// WithLenient to ignore words that are not numbers.
+func (p *Parser) ParseInt(s string) (int, error) {
+	ns, err := p.parse(s)
+	if err != nil {
+		return -1, err
+	}
+
+	n, err := p.ratios(ns).single()
+	if err != nil {
+		return -1, err
+	}
+
+	i, err := n.round(p.rounding)
+	if err != nil {
+		return -1, err
+	}
+
+	return i, nil
+}
+
+// Parse reads a text string made up entirely of numbers, returning the reduced
+// set of numbers. ErrNonNumber is returned if any part of it is not a number,
+// unless the parser is lenient. In strict mode, a MalformedError is returned if
+// the numbers are not a single grammatical number.
+func (p *Parser) parse(s string) (numbers, error) {
+	ns, _, err := p.parseSpan(s)
+	return ns, err
+}
+
+// ParseSpan reads a text string like parse, also returning the span of the
+// words of its numbers.
+func (p *Parser) parseSpan(s string) (numbers, Span, error) {
+	toks := p.tokenize(s)
+	if p.lenient {
+		return p.parseLenient(s, toks)
+	}
+
+	in := cores(toks)
 	buf := numbers{}
 
 	ok := false
 	for i := range in {
-		if buf, ok = readIntoBuffer(i, in, buf); !ok {
-			return -1, ErrNonNumber
+		if buf, ok = p.readIntoBuffer(i, in, buf); !ok {
+			return nil, Span{}, ErrNonNumber
+		}
+	}
+
+	ns, err := p.reduceWords(toks, 0, buf)
+	return ns, spanOf(s, toks), err
+}
+
+// ParseLenient reads the numbers in the tokens, skipping any words that are not
+// numbers.
+func (p *Parser) parseLenient(s string, toks []token) (out numbers, sp Span, err error) {
+	p.readRuns(toks, cores(toks), func(start, end int, ns numbers) {
+		if err != nil {
+			return
+		}
+
+		if ns, err = p.reduceWords(toks, start, ns); err == nil {
+			if len(out) == 0 {
+				sp = spanOf(s, toks[start:end])
+			}
+			out = append(out, ns...)
 		}
+	}, nil)
+
+	if err != nil {
+		return nil, Span{}, err
+	}
+	return out, sp, nil
+}
+
+// ReduceWords reduces the numbers read from the tokens from start, one per
+// token. In strict mode, a MalformedError is returned if the numbers are not a
+// single grammatical number.
+func (p *Parser) reduceWords(toks []token, start int, ns numbers) (numbers, error) {
+	if p.strict {
+		return parseStrict(toks, start, ns)
 	}
+	return p.reduce(cores(toks[start:start+len(ns)]), ns), nil
+}
 
-	return reduce(buf).Int()
+// Ratios converts any percentages into their ratios if the parser is
+// configured to do so (eg, 50% => 0.5).
+func (p *Parser) ratios(ns numbers) numbers {
+	if p.percent == PercentRatio {
+		ns = ns.ratios()
+	}
+	return ns
 }
 
 // ParseString reads a text string and converts all numbers contained within to
 // their appropriate values. Integers are preserved exactly while floating point
 // numbers are limited to six decimal places. The rest of the string is preserved.
-func ParseString(s string) string {
-	in := explode(s)
-	out := ParseStrings(in)
+func (p *Parser) ParseString(s string) string {
+	out := p.parseTokens(p.tokenize(s))
 	return strings.Join(out, " ")
 }
 
 // ParseStrings performs the same actions as ParseString but operates on a pre-
 // sanitized and split string. This method is exposed for convenience if further
 // processing of the string is required.
-func ParseStrings(in []string) []string {
+func (p *Parser) ParseStrings(in []string) []string {
+	return p.parseTokens(splitTokens(in))
+}
+
+func (p *Parser) parseTokens(toks []token) []string {
+	if p.money {
+		return p.parseMoneyStrings(toks)
+	}
+	return p.parseStrings(toks)
+}
+
+// ParseStrings converts the numbers in the tokens, reattaching their
+// punctuation. Punctuation before or after a word ends any run of numbers
+// (eg, "twenty, five" => "20, 5").
+func (p *Parser) parseStrings(toks []token) []string {
 	out := make([]string, 0, 1)
+
+	scan := cores(toks)
+	if p.fractions == FractionAsWritten {
+		scan = p.maskSlashFractions(scan)
+	}
+
+	p.readRuns(toks, scan, func(start, end int, ns numbers) {
+		out = p.flushTokens(toks[start:end], ns, out)
+	}, func(i int) {
+		out = append(out, toks[i].String())
+	})
+
+	return out
+}
+
+// ReadRuns reads the runs of numbers in the tokens, calling run with the range
+// of tokens in each run and the numbers read from them, one per token, and
+// other (if not nil) with the index of each token that is not a number.
+// Punctuation before or after a word ends any run of numbers. NB: the numbers
+// are reused for the next run.
+func (p *Parser) readRuns(toks []token, in []string, run func(start, end int, ns numbers), other func(i int)) {
 	buf := numbers{}
+	flush := func(end int) {
+		if len(buf) > 0 {
+			run(end-len(buf), end, buf)
+			buf = buf[:0]
+		}
+	}
 
 	ok := false
+	for i, t := range toks {
+		if len(buf) > 0 && (t.pre != "" || toks[i-1].post != "") {
+			flush(i)
+		}
+
+		if buf, ok = p.readIntoBuffer(i, in, buf); !ok {
+			flush(i)
+			if other != nil {
+				other(i)
+			}
+		}
+	}
+
+	flush(len(toks))
+}
+
+// MaskSlashFractions returns a copy of in with fractions written with a slash
+// blanked out, so they are not read as numbers and are left intact in the output.
+func (p *Parser) maskSlashFractions(in []string) []string {
+	out := make([]string, len(in))
 	for i, s := range in {
-		if buf, ok = readIntoBuffer(i, in, buf); !ok {
-			out = buf.flush(out)
-			buf = buf[:0]
-			out = append(out, s)
+		if strings.ContainsAny(s, "/⁄") {
+			if _, ok := p.maybeNumeric(s); ok {
+				continue
+			}
 		}
+		out[i] = s
 	}
+	return out
+}
 
-	return buf.flush(out)
+// Explode splits the string into words, rejoining any digit groups separated by
+// spaces if the number format calls for it.
+func (p *Parser) explode(s string) []string {
+	return cores(p.tokenize(s))
 }
 
-func readIntoBuffer(i int, in []string, buf numbers) (out numbers, ok bool) {
+// Tokenize splits the string into tokens, rejoining any digit groups separated
+// by spaces if the number format calls for it.
+func (p *Parser) tokenize(s string) []token {
+	toks := splitWords(p.tokenizer.Tokenize(s))
+	if unicode.IsSpace(p.format.Group) {
+		toks = p.format.joinGroups(toks)
+	}
+	return toks
+}
+
+func (p *Parser) readIntoBuffer(i int, in []string, buf numbers) (out numbers, ok bool) {
+	if isClockFraction(in, i) {
+		return buf, false
+	}
+
 	s := in[i]
 	n, ok := lookupNumber(s)
 
-	if ok && n.typ != numAnd {
+	if ok && isArticle(s) {
+		ok = p.articles && takesArticle(in, i+1)
+		if ok {
+			buf = append(buf, n)
+		}
+		return buf, ok
+	} else if ok && n.typ != numAnd {
 		buf = append(buf, n)
 		return buf, ok
-	} else if ok && n.typ == numAnd && shouldIncludeAnd(in, buf, i) {
+	} else if ok && n.typ == numAnd && p.shouldIncludeAnd(in, buf, i) {
 		buf = append(buf, n)
 		return buf, ok
-	} else if n, ok = maybeNumeric(s); ok {
+	} else if n, ok = p.maybeNumeric(s); ok {
+		buf = append(buf, n)
+		return buf, ok
+	} else if n, ok = p.maybeRoman(s); ok {
+		buf = append(buf, n)
+		return buf, ok
+	} else if n, ok = p.maybePercent(s, buf); ok {
 		buf = append(buf, n)
 		return buf, ok
 	}
@@ -86,22 +284,87 @@ func readIntoBuffer(i int, in []string, buf numbers) (out numbers, ok bool) {
 	return buf, false
 }
 
-func shouldIncludeAnd(in []string, buf numbers, idx int) bool {
+func (p *Parser) shouldIncludeAnd(in []string, buf numbers, idx int) bool {
 	if len(buf) == 0 || idx+1 >= len(in) {
 		return false
 	}
 
 	prev := buf[len(buf)-1]
 
-	if prev.ordinal || prev.typ == numFraction {
+	switch {
+	case prev.ordinal, prev.typ == numFraction, prev.typ == numSlashFraction:
+		return false
+	case prev.typ == numPercent, prev.typ == numPercentSign:
+		return false
+	}
+
+	// "and" separates the bounds of a range (eg, "between five and ten")
+	if start := idx - len(buf); start > 0 && strings.EqualFold(in[start-1], "between") {
+		return false
+	}
+
+	switch p.and {
+	case AndNever:
+		return false
+	case AndBritish:
+		return afterMultiplier(in, idx) && p.isNumber(in, idx+1)
+	case AndAlways:
+		return p.isNumber(in, idx+1)
+	}
+
+	if afterMultiplier(in, idx) {
+		return p.isNumber(in, idx+1)
+	}
+
+	// the numbers ahead are only peeked at, so they are not traced
+	next, _ := p.readRun(in, idx+1, nil)
+	ns := p.evaluate(next)
+	return len(ns) > 0 && (ns[0].typ == numFraction || ns[0].typ == numSlashFraction || ns[0].denominator != 1)
+}
+
+// IsNumber returns true if the ith word is a number in the dictionary or
+// written with digits
+func (p *Parser) isNumber(in []string, i int) bool {
+	if isArticle(in[i]) {
+		return p.articles && takesArticle(in, i+1)
+	}
+
+	if _, ok := lookupNumber(in[i]); ok {
+		return true
+	}
+	_, ok := p.maybeNumeric(in[i])
+	return ok
+}
+
+// AfterMultiplier returns true if the word before the ith is a multiplier word
+// (eg, "hundred" or "thousand").
+func afterMultiplier(in []string, idx int) bool {
+	n, ok := lookupNumber(in[idx-1])
+	return ok && n.typ == numBig
+}
+
+// IsArticle returns true if the word is "a" or "an"
+func isArticle(s string) bool {
+	return strings.EqualFold(s, "a") || strings.EqualFold(s, "an")
+}
+
+// TakesArticle returns true if the ith word is a number that may be preceded
+// by "a" or "an" to mean one of it, such as a multiplier ("a hundred", "a
+// dozen") or a fraction ("a half", "an eighth").
+func takesArticle(in []string, i int) bool {
+	if i >= len(in) {
 		return false
 	}
 
-	s := in[idx+1]
-	if _, ok := lookupNumber(s); !ok {
-		_, ok = maybeNumeric(s)
-		return ok
+	n, ok := lookupNumber(in[i])
+	switch {
+	case !ok:
+		return false
+	case n.typ == numBig, n.typ == numFraction:
+		return true
+	case n.ordinal:
+		return n.numerator > 2
 	}
 
-	return true
+	return false
 }
@@ -0,0 +1,194 @@
+package numwords
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrNotOrdinal is returned by ParseOrdinal if the input is a single number
+// that is not an ordinal.
+var ErrNotOrdinal = errors.New("the input is not an ordinal number")
+
+// Kind is the kind of a Number
+type Kind int8
+
+const (
+	// KindCardinal is a whole number (eg, "twenty five")
+	KindCardinal Kind = iota
+
+	// KindOrdinal is an ordinal number (eg, "twenty second")
+	KindOrdinal
+
+	// KindFraction is a number that is not whole (eg, "two and a half")
+	KindFraction
+
+	// KindYear is a colloquial year (eg, "nineteen eighty eight")
+	KindYear
+
+	// KindOverflow is a number too large to be represented exactly (eg, "ten
+	// quintillion"). Its Numerator and Denominator are zero.
+	KindOverflow
+)
+
+var kindNames = [...]string{
+	KindCardinal: "cardinal",
+	KindOrdinal:  "ordinal",
+	KindFraction: "fraction",
+	KindYear:     "year",
+	KindOverflow: "overflow",
+}
+
+// String returns the name of the kind
+func (k Kind) String() string {
+	if k >= 0 && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Number is a number read by Parse or ParseAll. Its value is kept exactly as a
+// fraction in lowest terms, with a positive denominator, unless its Kind is
+// KindOverflow.
+type Number struct {
+	// Numerator is the numerator of the number's exact value.
+	Numerator int
+
+	// Denominator is the denominator of the number's exact value, which is 1
+	// for whole numbers.
+	Denominator int
+
+	// Kind is the kind of the number.
+	Kind Kind
+
+	// Span is the location of the words of the number in the input string.
+	Span Span
+}
+
+// newNumber converts an internal number to a Number
+func newNumber(n number, sp Span) Number {
+	num, den := n.numerator, n.denominator
+	if den < 0 {
+		num, den = -num, -den
+	}
+	if g := gcd(num, den); g > 1 {
+		num, den = num/g, den/g
+	}
+
+	kind := KindCardinal
+	switch {
+	case n.overflowed():
+		kind = KindOverflow
+	case n.ordinal:
+		kind = KindOrdinal
+	case n.typ == numYear:
+		kind = KindYear
+	case den > 1:
+		kind = KindFraction
+	}
+
+	return Number{
+		Numerator:   num,
+		Denominator: den,
+		Kind:        kind,
+		Span:        sp,
+	}
+}
+
+// Value returns the value of the number
+func (n Number) Value() float64 {
+	return float64(n.Numerator) / float64(n.Denominator)
+}
+
+// IsOrdinal returns true if the number is an ordinal (eg, "twenty second")
+func (n Number) IsOrdinal() bool {
+	return n.Kind == KindOrdinal
+}
+
+// IsFraction returns true if the number is not a whole number (eg, "two and a
+// half")
+func (n Number) IsFraction() bool {
+	return n.Kind == KindFraction
+}
+
+// IsYear returns true if the number was read as a colloquial year (eg,
+// "nineteen eighty eight")
+func (n Number) IsYear() bool {
+	return n.Kind == KindYear
+}
+
+// Parse reads a text string and converts it to a Number. An error is returned
+// if the string cannot be resolved to a single number.
+func Parse(s string) (Number, error) {
+	return defaultParser.Parse(s)
+}
+
+// ParseOrdinal reads an ordinal number (eg, "twenty second" or "22nd") and
+// converts it to its integer value. ErrNotOrdinal is returned if the input is a
+// single number that is not an ordinal.
+func ParseOrdinal(s string) (int, error) {
+	return defaultParser.ParseOrdinal(s)
+}
+
+// ParseAll reads a text string and returns every number contained within, in
+// order, along with its kind and location (eg, "three apples and two bananas"
+// => 3, 2). The rest of the string is ignored. Numbers too large to be
+// represented exactly are returned with KindOverflow.
+func ParseAll(s string) []Number {
+	return defaultParser.ParseAll(s)
+}
+
+// Parse reads a text string and converts it to a Number. An error is returned
+// if the string cannot be resolved to a single number.
+func (p *Parser) Parse(s string) (Number, error) {
+	ns, sp, err := p.parseSpan(s)
+	if err != nil {
+		return Number{}, err
+	}
+
+	n, err := p.ratios(ns).single()
+	if err != nil {
+		return Number{}, err
+	}
+
+	return newNumber(n, sp), nil
+}
+
+// ParseOrdinal reads an ordinal number (eg, "twenty second" or "22nd") and
+// converts it to its integer value. ErrNotOrdinal is returned if the input is a
+// single number that is not an ordinal.
+func (p *Parser) ParseOrdinal(s string) (int, error) {
+	n, err := p.Parse(s)
+	if err != nil {
+		return -1, err
+	}
+
+	if !n.IsOrdinal() {
+		return -1, ErrNotOrdinal
+	}
+
+	return n.Numerator, nil
+}
+
+// ParseAll reads a text string and returns every number contained within, in
+// order, along with its kind and location (eg, "three apples and two bananas"
+// => 3, 2). The rest of the string is ignored. Numbers too large to be
+// represented exactly are returned with KindOverflow.
+func (p *Parser) ParseAll(s string) []Number {
+	toks := p.tokenize(s)
+	out := []Number{}
+
+	p.readRuns(toks, cores(toks), func(start, end int, ns numbers) {
+		spans := make([]Span, 0, end-start)
+		for _, t := range toks[start:end] {
+			spans = append(spans, coreSpan(s, t.Span))
+		}
+
+		ns, spans = p.reduceSpans(ns, spans)
+		ns = p.ratios(ns)
+		for i, n := range ns {
+			out = append(out, newNumber(n, spans[i]))
+		}
+	}, nil)
+
+	return out
+}
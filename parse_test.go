@@ -0,0 +1,156 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		num, den int
+		value    float64
+		ordinal  bool
+		fraction bool
+		year     bool
+	}{
+		{"twenty two", 22, 1, 22, false, false, false},
+		{"twenty second", 22, 1, 22, true, false, false},
+		{"22nd", 22, 1, 22, true, false, false},
+		{"one hundred twentieth", 120, 1, 120, true, false, false},
+		{"two and a half", 5, 2, 2.5, false, true, false},
+		{"two thirds", 2, 3, 2.0 / 3, false, true, false},
+		{"four halves", 2, 1, 2, false, false, false},
+		{"2 1/2", 5, 2, 2.5, false, true, false},
+		{"nineteen eighty eight", 1988, 1, 1988, false, false, true},
+		{"twenty ten", 2010, 1, 2010, false, false, true},
+		{"two thousand and ten", 2010, 1, 2010, false, false, false},
+		{"zero", 0, 1, 0, false, false, false},
+	}
+
+	for _, test := range tests {
+		n, err := Parse(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.num, n.Numerator, test.in)
+			assert.Equal(t, test.den, n.Denominator, test.in)
+			assert.Equal(t, test.value, n.Value(), test.in)
+			assert.Equal(t, test.ordinal, n.IsOrdinal(), test.in)
+			assert.Equal(t, test.fraction, n.IsFraction(), test.in)
+			assert.Equal(t, test.year, n.IsYear(), test.in)
+		}
+	}
+
+	_, err := Parse("foo")
+	assert.Equal(t, ErrNonNumber, err)
+
+	_, err = Parse("two three")
+	assert.Equal(t, ErrManyNumbers, err)
+
+	_, err = Parse("")
+	assert.Equal(t, ErrNoNumbers, err)
+
+	n, err := New(WithPercentages(PercentRatio)).Parse("fifty percent")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Number{Numerator: 1, Denominator: 2, Kind: KindFraction, Span: Span{0, 13}}, n)
+	}
+
+	n, err = Parse(" (twenty-two) ")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Span{2, 12}, n.Span)
+	}
+}
+
+func TestParse_ParseOrdinal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out int
+	}{
+		{"first", 1},
+		{"twenty second", 22},
+		{"22nd", 22},
+		{"one hundred and first", 101},
+		{"two thousandth", 2000},
+		{"zeroth", 0},
+	}
+
+	for _, test := range tests {
+		i, err := ParseOrdinal(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, i, test.in)
+		}
+	}
+
+	_, err := ParseOrdinal("twenty two")
+	assert.Equal(t, ErrNotOrdinal, err)
+
+	_, err = ParseOrdinal("one fifth")
+	assert.Equal(t, ErrNotOrdinal, err)
+
+	_, err = ParseOrdinal("first second")
+	assert.Equal(t, ErrManyNumbers, err)
+
+	_, err = ParseOrdinal("foo")
+	assert.Equal(t, ErrNonNumber, err)
+}
+
+func TestParse_ParseAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out []Number
+	}{
+		{"three apples and two bananas", []Number{
+			{3, 1, KindCardinal, Span{0, 5}},
+			{2, 1, KindCardinal, Span{17, 20}},
+		}},
+		{"the twenty second of may, nineteen eighty eight", []Number{
+			{22, 1, KindOrdinal, Span{4, 17}},
+			{1988, 1, KindYear, Span{26, 47}},
+		}},
+		{"add two and a half cups (1/4 of the bag)", []Number{
+			{5, 2, KindFraction, Span{4, 18}},
+			{1, 4, KindFraction, Span{25, 28}},
+		}},
+		{"one hundred and five, six", []Number{
+			{105, 1, KindCardinal, Span{0, 20}},
+			{6, 1, KindCardinal, Span{22, 25}},
+		}},
+		{"one hundred five thousand", []Number{
+			{105000, 1, KindCardinal, Span{0, 25}},
+		}},
+		{"fifteen hundredth and two fourth", []Number{
+			{1500, 1, KindOrdinal, Span{0, 17}},
+			{2, 1, KindCardinal, Span{22, 25}},
+			{4, 1, KindOrdinal, Span{26, 32}},
+		}},
+		{"ten quintillion apples", []Number{
+			{0, 0, KindOverflow, Span{0, 15}},
+		}},
+		{".5 and 0.25", []Number{
+			{1, 2, KindFraction, Span{0, 2}},
+			{1, 4, KindFraction, Span{7, 11}},
+		}},
+		{"two and a half to three hours", []Number{
+			{5, 2, KindFraction, Span{0, 14}},
+			{3, 1, KindCardinal, Span{18, 23}},
+		}},
+		{"no numbers here", []Number{}},
+		{"", []Number{}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, ParseAll(test.in), test.in)
+		assert.Equal(t, test.out, New(WithEngine(EngineGrammar)).ParseAll(test.in), test.in)
+	}
+
+	p := New(WithPercentages(PercentRatio))
+	assert.Equal(t, []Number{
+		{1, 4, KindFraction, Span{8, 18}},
+	}, p.ParseAll("save up 25 percent!"))
+}
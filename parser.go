@@ -0,0 +1,204 @@
+package numwords
+
+// Parser converts textual numbers to their numeric values according to its
+// configuration. A Parser is safe for concurrent use. The package-level
+// functions use a Parser with the default configuration.
+type Parser struct {
+	format    NumberFormat
+	fractions FractionFormat
+	roman     bool
+	percent   PercentMode
+	money     bool
+	units     UnitTable
+	dateOrder DateOrder
+	couple    bool
+	and       AndPolicy
+	articles  bool
+	tokenizer Tokenizer
+	rules     *RuleSet
+	engine    Engine
+	strict    bool
+	lenient   bool
+	rounding  Rounding
+
+	traces *[]Trace // collects traces while explaining
+}
+
+// Option configures a Parser created via New.
+type Option func(p *Parser)
+
+// New creates a Parser with the default configuration, modified by the
+// provided options.
+func New(opts ...Option) *Parser {
+	p := &Parser{
+		format:    DefaultNumberFormat,
+		units:     DefaultUnits,
+		articles:  true,
+		tokenizer: WordTokenizer{},
+		rules:     defaultRuleSet,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// defaultParser backs the package-level functions.
+var defaultParser = New()
+
+// WithNumberFormat sets the separators used to read numbers written with
+// digits (eg, "1.234,56" with EuropeanNumberFormat).
+func WithNumberFormat(f NumberFormat) Option {
+	return func(p *Parser) {
+		p.format = f
+	}
+}
+
+// WithFractionFormat sets how ParseString writes numbers with a fractional
+// portion. The default is FractionAsWritten.
+func WithFractionFormat(f FractionFormat) Option {
+	return func(p *Parser) {
+		p.fractions = f
+	}
+}
+
+// WithRomanNumerals toggles whether or not uppercase roman numerals (eg, "XIV")
+// are read as numbers. The default is false.
+func WithRomanNumerals(enabled bool) Option {
+	return func(p *Parser) {
+		p.roman = enabled
+	}
+}
+
+// WithPercentages sets whether or not percentages (eg, "fifty percent" or "50%")
+// are read as numbers. The default is PercentIgnore.
+func WithPercentages(m PercentMode) Option {
+	return func(p *Parser) {
+		p.percent = m
+	}
+}
+
+// WithMoney toggles whether or not ParseString normalizes amounts of money
+// (eg, "three dollars and fifty cents" => "$3.50"). The default is false.
+func WithMoney(enabled bool) Option {
+	return func(p *Parser) {
+		p.money = enabled
+	}
+}
+
+// WithUnits sets the units of measure read by ExtractQuantities. The default
+// is DefaultUnits.
+func WithUnits(t UnitTable) Option {
+	return func(p *Parser) {
+		p.units = t
+	}
+}
+
+// WithDateOrder sets the order of the day and month in dates written only
+// with digits (eg, "07/10/1990"). The default is MonthDayYear.
+func WithDateOrder(o DateOrder) Option {
+	return func(p *Parser) {
+		p.dateOrder = o
+	}
+}
+
+// WithCouple toggles whether or not ParseDuration reads "a couple of" as two
+// (eg, "a couple of minutes"). The default is false.
+func WithCouple(enabled bool) Option {
+	return func(p *Parser) {
+		p.couple = enabled
+	}
+}
+
+// AndPolicy determines when a Parser reads "and" between two numbers as part
+// of a single number (eg, "one hundred and five").
+type AndPolicy int8
+
+const (
+	// AndHeuristic joins numbers with "and" after a multiplier word (eg,
+	// "hundred" or "thousand") or before a fraction (eg, "two and a half"), so
+	// lists like "one, two and three" remain separate numbers.
+	AndHeuristic AndPolicy = iota
+
+	// AndBritish joins numbers with "and" only after a multiplier word (eg,
+	// "one hundred and five").
+	AndBritish
+
+	// AndAlways joins numbers with "and" whenever another number follows it.
+	AndAlways
+
+	// AndNever does not join numbers with "and".
+	AndNever
+)
+
+// WithAndPolicy sets when "and" joins two numbers into one. The default is
+// AndHeuristic.
+func WithAndPolicy(a AndPolicy) Option {
+	return func(p *Parser) {
+		p.and = a
+	}
+}
+
+// WithArticles toggles whether or not "a" and "an" are read as one before a
+// multiplier or fraction (eg, "a hundred" or "an eighth"). The default is true.
+func WithArticles(enabled bool) Option {
+	return func(p *Parser) {
+		p.articles = enabled
+	}
+}
+
+// WithTokenizer sets the Tokenizer used to split text into words. The default
+// is WordTokenizer.
+func WithTokenizer(t Tokenizer) Option {
+	return func(p *Parser) {
+		p.tokenizer = t
+	}
+}
+
+// WithRules sets the rules used to combine adjacent numbers (eg, "one hundred"
+// => 100). The default is a RuleSet of DefaultRules.
+func WithRules(rs *RuleSet) Option {
+	return func(p *Parser) {
+		p.rules = rs
+	}
+}
+
+// WithStrict toggles whether or not ParseInt and ParseFloat reject numbers
+// that are not grammatical (eg, "hundred hundred", "five five" or "thirty
+// ten"), returning a MalformedError. Strict numbers are combined with the
+// grammar of EngineGrammar. The default is false.
+func WithStrict(enabled bool) Option {
+	return func(p *Parser) {
+		p.strict = enabled
+	}
+}
+
+// WithLenient toggles whether or not ParseInt, ParseFloat and Parse ignore the
+// words around a number that are not numbers (eg, "about twenty five items" =>
+// 25), rather than returning ErrNonNumber. As with ParseString, punctuation
+// ends a number. ErrManyNumbers and ErrNoNumbers are still returned unless
+// there is exactly one number. The default is false.
+func WithLenient(enabled bool) Option {
+	return func(p *Parser) {
+		p.lenient = enabled
+	}
+}
+
+// WithRounding sets how ParseInt and ParseAs round numbers that are not whole
+// to integers (eg, "twelve and a half" => 13 with RoundHalfUp). The default is
+// RoundTruncate.
+func WithRounding(r Rounding) Option {
+	return func(p *Parser) {
+		p.rounding = r
+	}
+}
+
+// WithEngine sets how adjacent numbers are combined into their values. The
+// default is EnginePatterns.
+func WithEngine(e Engine) Option {
+	return func(p *Parser) {
+		p.engine = e
+	}
+}
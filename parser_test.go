@@ -0,0 +1,393 @@
+package numwords
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParser_New(t *testing.T) {
+	t.Parallel()
+
+	p := New()
+	assert.Equal(t, DefaultNumberFormat, p.format)
+}
+
+func TestParser_WithNumberFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		f   NumberFormat
+		in  string
+		out string
+	}{
+		{DefaultNumberFormat, "1,234.5 apples", "1234.5 apples"},
+		{EuropeanNumberFormat, "1.234,5 apples", "1234.5 apples"},
+		{EuropeanNumberFormat, "3,25 apples", "3.25 apples"},
+		{SpaceNumberFormat, "1 234 567 apples", "1234567 apples"},
+		{SpaceNumberFormat, "3 4 apples", "3 4 apples"},
+		{SwissNumberFormat, "1'000 francs", "1000 francs"},
+	}
+
+	for _, test := range tests {
+		p := New(WithNumberFormat(test.f))
+		assert.Equal(t, test.out, p.ParseString(test.in), "%+v", test)
+	}
+
+	f, err := New(WithNumberFormat(EuropeanNumberFormat)).ParseFloat("1.000,5")
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.5, f)
+}
+
+func TestParser_WithFractionFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		f   FractionFormat
+		in  string
+		out string
+	}{
+		{FractionAsWritten, "three 3/4 cups", "3 3/4 cups"},
+		{FractionAsWritten, "two and 1/2 cups", "2 and 1/2 cups"},
+		{FractionAsWritten, "two and a half cups", "2.5 cups"},
+		{FractionDecimal, "three 3/4 cups", "3.75 cups"},
+		{FractionDecimal, "1-1/2 inches", "1.5 inches"},
+		{FractionDecimal, "two and a half cups", "2.5 cups"},
+		{FractionSlash, "three 3/4 cups", "3 3/4 cups"},
+		{FractionSlash, "two and a half cups", "2 1/2 cups"},
+		{FractionSlash, "two thirds", "2/3"},
+		{FractionSlash, "twenty second", "22nd"},
+	}
+
+	for _, test := range tests {
+		p := New(WithFractionFormat(test.f))
+		assert.Equal(t, test.out, p.ParseString(test.in), "%+v", test)
+	}
+}
+
+func TestParser_WithRomanNumerals(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithRomanNumerals(true))
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"Chapter XIV", "Chapter 14"},
+		{"Louis XVI", "Louis 16"},
+		{"Super Bowl LVII", "Super Bowl 57"},
+		{"I like to MIX it up", "I like to MIX it up"},
+		{"XX thousand", "20000"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, p.ParseString(test.in), test.in)
+	}
+
+	i, err := p.ParseInt("MCMXC")
+	assert.NoError(t, err)
+	assert.Equal(t, 1990, i)
+
+	assert.Equal(t, "Chapter XIV", ParseString("Chapter XIV"))
+}
+
+func TestParser_WithPercentages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		m   PercentMode
+		in  string
+		out string
+		val float64
+	}{
+		{PercentIgnore, "fifty percent", "50 percent", -1},
+		{PercentIgnore, "50%", "50%", -1},
+		{PercentValue, "fifty percent", "50%", 50},
+		{PercentValue, "twelve and a half per cent", "12.5%", 12.5},
+		{PercentValue, "50 %", "50%", 50},
+		{PercentRatio, "fifty percent", "50%", 0.5},
+		{PercentRatio, "one hundred and ten percent", "110%", 1.1},
+		{PercentRatio, "20%", "20%", 0.2},
+		{PercentValue, "nineteen ten percent", "1910 percent", -1},
+		{PercentValue, "nineteen eighty percent", "1980 percent", -1},
+		{PercentRatio, "nineteen eighty percent", "1980 percent", -1},
+	}
+
+	for _, test := range tests {
+		p := New(WithPercentages(test.m))
+		assert.Equal(t, test.out, p.ParseString(test.in), "%+v", test)
+
+		f, err := p.ParseFloat(test.in)
+		if test.val < 0 {
+			assert.Equal(t, ErrNonNumber, err, "%+v", test)
+		} else if assert.NoError(t, err, "%+v", test) {
+			assert.Equal(t, test.val, f, "%+v", test)
+		}
+	}
+
+	p := New(WithPercentages(PercentValue))
+	s := p.ParseString("the percent of five percent and six rose three percent")
+	assert.Equal(t, "the percent of 5% and 6 rose 3%", s)
+
+	s = p.ParseString("ten quintillion percent")
+	assert.Equal(t, "ten quintillion percent", s, "overflow is left as written")
+}
+
+func TestParser_WithMoney(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithMoney(true))
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"it was three dollars and fifty cents", "it was $3.50"},
+		{"I owe you five quid and two apples", "I owe you £5.00 and 2 apples"},
+		{"two grand", "2000"},
+		{"$5k bonus", "$5000.00 bonus"},
+		{"three apples", "3 apples"},
+		{"5 10 dollars", "5 $10.00"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, p.ParseString(test.in), test.in)
+	}
+
+	assert.Equal(t, "3 dollars and 50 cents", ParseString("three dollars and fifty cents"))
+}
+
+func TestParser_WithDateOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		o     DateOrder
+		in    string
+		month time.Month
+		day   int
+	}{
+		{MonthDayYear, "07/10/1990", time.July, 10},
+		{DayMonthYear, "07/10/1990", time.October, 7},
+		{DayMonthYear, "10.07.1990", time.July, 10},
+		{DayMonthYear, "1990-07-10", time.July, 10},
+		{DayMonthYear, "the tenth of July nineteen ninety", time.July, 10},
+	}
+
+	for _, test := range tests {
+		d, err := New(WithDateOrder(test.o)).ParseDate(test.in)
+		if assert.NoError(t, err, "%+v", test) {
+			assert.Equal(t, time.Date(1990, test.month, test.day, 0, 0, 0, 0, time.UTC), d, "%+v", test)
+		}
+	}
+
+	_, err := New(WithDateOrder(DayMonthYear)).ParseDate("07/13/1990")
+	assert.True(t, errors.Is(err, ErrInvalidDate))
+}
+
+func TestParser_WithCouple(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithCouple(true))
+
+	d, err := p.ParseDuration("a couple of minutes")
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, d)
+
+	d, err = p.ParseDuration("couple hours and a couple of minutes")
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Hour+2*time.Minute, d)
+
+	_, err = ParseDuration("a couple of minutes")
+	assert.Equal(t, ErrInvalidDuration, err)
+}
+
+func TestParser_WithAndPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a   AndPolicy
+		in  string
+		out string
+	}{
+		{AndHeuristic, "one hundred and five", "105"},
+		{AndHeuristic, "two and a half", "2.5"},
+		{AndHeuristic, "three and two apples", "3 and 2 apples"},
+		{AndBritish, "one hundred and five", "105"},
+		{AndBritish, "two and a half", "2 and 0.5"},
+		{AndBritish, "three and two apples", "3 and 2 apples"},
+		{AndAlways, "one hundred and five", "105"},
+		{AndAlways, "two and a half", "2.5"},
+		{AndAlways, "three and two apples", "3 2 apples"},
+		{AndNever, "one hundred and five", "100 and 5"},
+		{AndNever, "two and a half", "2 and 0.5"},
+		{AndNever, "three and two apples", "3 and 2 apples"},
+		{AndAlways, "between five and ten", "between 5 and 10"},
+	}
+
+	for _, test := range tests {
+		p := New(WithAndPolicy(test.a))
+		assert.Equal(t, test.out, p.ParseString(test.in), "%+v", test)
+	}
+}
+
+func TestParser_WithArticles(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithArticles(false))
+
+	assert.Equal(t, "a 100 and a 0.5", p.ParseString("a hundred and a half"))
+	assert.Equal(t, "100.5", ParseString("a hundred and a half"))
+	assert.Empty(t, p.ExtractQuantities("an hour"))
+
+	_, err := p.ParseDuration("an hour")
+	assert.Equal(t, ErrInvalidDuration, err)
+}
+
+func TestParser_WithTokenizer(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithTokenizer(UnicodeTokenizer{}))
+	assert.Equal(t, "(7), 25 and 1/2", p.ParseString("(seven), twenty-five and 1/2"))
+	assert.Equal(t, "3 apples", p.ParseString("three apples"))
+
+	f, err := p.ParseFloat("two and a half")
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, f)
+
+	segments := TokenizerFunc(func(s string) []Token {
+		return []Token{{s[0:6], Span{0, 6}}, {s[6:10], Span{6, 10}}}
+	})
+	assert.Equal(t, "25", New(WithTokenizer(segments)).ParseString("twentyfive"))
+}
+
+func TestParser_WithRules(t *testing.T) {
+	t.Parallel()
+
+	rules := append(DefaultRules(), Rule{"ss", 5, ActionMultiply, "two three => 6"})
+	rs, err := NewRuleSet(rules...)
+	if assert.NoError(t, err) {
+		p := New(WithRules(rs))
+		assert.Equal(t, "6 apples", p.ParseString("two three apples"))
+		assert.Equal(t, "2 3 apples", ParseString("two three apples"))
+	}
+}
+
+func TestParser_WithEngine(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithEngine(EngineGrammar))
+	assert.Equal(t, "205000 apples", p.ParseString("two hundred five thousand apples"))
+	assert.Equal(t, "2 3 apples", p.ParseString("two three apples"))
+
+	rs, err := NewRuleSet(append(DefaultRules(), Rule{"ss", 5, ActionMultiply, "two three => 6"})...)
+	if assert.NoError(t, err) {
+		p = New(WithEngine(EngineGrammar), WithRules(rs))
+		assert.Equal(t, "2 3 apples", p.ParseString("two three apples"), "rules are not used")
+	}
+}
+
+func TestParser_WithLenient(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithLenient(true))
+
+	tests := []struct {
+		in  string
+		out float64
+		err error
+	}{
+		{"about twenty five", 25, nil},
+		{"twenty five items", 25, nil},
+		{"roughly two and a half cups of flour", 2.5, nil},
+		{"the 3rd time", 3, nil},
+		{"25", 25, nil},
+		{"three apples and two bananas", -1, ErrManyNumbers},
+		{"twenty, five", -1, ErrManyNumbers},
+		{"no numbers here", -1, ErrNoNumbers},
+		{"", -1, ErrNoNumbers},
+	}
+
+	for _, test := range tests {
+		f, err := p.ParseFloat(test.in)
+		assert.Equal(t, test.err, err, test.in)
+		assert.Equal(t, test.out, f, test.in)
+	}
+
+	i, err := p.ParseInt("about twenty five items")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 25, i)
+	}
+
+	n, err := p.Parse("about (twenty five) items")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Number{25, 1, KindCardinal, Span{7, 18}}, n)
+	}
+
+	_, err = ParseInt("about twenty five")
+	assert.Equal(t, ErrNonNumber, err, "not lenient by default")
+
+	_, err = New(WithLenient(true), WithStrict(true)).ParseInt("about five five items")
+	var me *MalformedError
+	if assert.True(t, errors.As(err, &me)) {
+		assert.Equal(t, 2, me.Index)
+		assert.Equal(t, Span{11, 15}, me.Span)
+	}
+}
+
+func TestParser_WithRounding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		mode Rounding
+		out  int
+		err  error
+	}{
+		{"twelve and a half", RoundTruncate, 12, nil},
+		{"two thirds", RoundTruncate, 0, nil},
+		{"twelve and a half", RoundFloor, 12, nil},
+		{"two thirds", RoundCeil, 1, nil},
+		{"twelve and a half", RoundHalfUp, 13, nil},
+		{"one third", RoundHalfUp, 0, nil},
+		{"twelve and a half", RoundHalfEven, 12, nil},
+		{"thirteen and a half", RoundHalfEven, 14, nil},
+		{"twelve and a half", RoundReject, -1, ErrNotInteger},
+		{"four halves", RoundReject, 2, nil},
+		{"twelve", RoundReject, 12, nil},
+	}
+
+	for _, test := range tests {
+		i, err := New(WithRounding(test.mode)).ParseInt(test.in)
+		assert.Equal(t, test.err, err, test.in)
+		assert.Equal(t, test.out, i, test.in)
+	}
+
+	u, err := ParseAsWith[uint8](New(WithRounding(RoundHalfUp)), "two hundred fifty four and a half")
+	if assert.NoError(t, err) {
+		assert.Equal(t, uint8(255), u)
+	}
+
+	_, err = ParseAsWith[uint8](New(WithRounding(RoundHalfUp)), "two hundred fifty five and a half")
+	assert.Equal(t, ErrOutOfRange, err)
+
+	_, err = ParseAsWith[int](New(WithRounding(RoundReject)), "two thirds")
+	assert.Equal(t, ErrNotInteger, err)
+
+	f, err := ParseAsWith[float64](New(WithRounding(RoundReject)), "two and a half")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 2.5, f, "floats are not rounded")
+	}
+
+	i, err := New(WithRounding(RoundHalfUp), WithPercentages(PercentRatio)).ParseInt("fifty percent")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, i)
+	}
+
+	i, err = New(WithRounding(RoundReject)).ParseInt("nine quadrillion one")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 9000000000000001, i, "exact above 2^53")
+	}
+}
@@ -2,107 +2,83 @@ package numwords
 
 type patternHandler func(ns numbers, idx int) numbers
 
-// Patterns describes all the salient number patterns that could be in a
+// defaultRules describes all the salient number patterns that could be in a
 // numbers set. These patterns are evaluated in the order listed here until all
 // options are exhausted.
-var patterns = []string{
+var defaultRules = prioritize([]Rule{
 	// tens
-	"ts", // twenty three => 23
+	{"ts", 0, ActionCombine, "twenty three => 23"},
 
 	// big
-	"bdb", // million eighteen thousand => 1018000
-	"db",  // eleven hundred            => 1100
-	"sb",  // one hundred               => 100
-	"btb", // million twenty thousand   => 1020000
-	"tb",  // twenty thousand           => 20000
-	"bd",  // hundred eleven            => 111
-	"bsb", // thousand two hundred      => 1200
-	"bs",  // hundred one               => 101
-	"bt",  // hundred twenty            => 120
-	"bbb", // million hundred thousand  => 1100000
-	"bb",  // hundred thousand          => 100000
+	{"bdb", 0, ActionCombineToLowest, "million eighteen thousand => 1018000"},
+	{"db", 0, ActionCombine, "eleven hundred => 1100"},
+	{"bsb", 0, ActionCombineToLowest, "thousand two hundred => 1200"},
+	{"sb", 0, ActionCombine, "one hundred => 100"},
+	{"btb", 0, ActionCombineToLowest, "million twenty thousand => 1020000"},
+	{"tb", 0, ActionCombine, "twenty thousand => 20000"},
+	{"bd", 0, ActionCombine, "hundred eleven => 111"},
+	{"bs", 0, ActionCombine, "hundred one => 101"},
+	{"bt", 0, ActionCombine, "hundred twenty => 120"},
+	{"bbb", 0, ActionCombineToLowest, "million hundred thousand => 1100000"},
+	{"bb", 0, ActionCombine, "hundred thousand => 100000"},
 
 	// direct
-	"dd", // nineteen ten    => 1910
-	"dt", // nineteen eighty => 1980
-	"td", // twenty fifteen  => 2015
+	{"dd", 0, ActionYearOrDone, "nineteen ten => 1910"},
+	{"dt", 0, ActionYearOrDone, "nineteen eighty => 1980"},
+	{"td", 0, ActionYearOrDone, "twenty fifteen => 2015"},
+
+	// slash fraction
+	{"d/", 0, ActionAdd, "eleven 1/2 => 11.5"},
+	{"s/", 0, ActionAdd, "two 1/2 => 2.5"},
+	{"t/", 0, ActionAdd, "twenty 1/2 => 20.5"},
+	{"b/", 0, ActionAdd, "hundred 1/2 => 100.5"},
 
 	// fraction
-	"df", // fifteen twentieths  => 0.75
-	"sf", // three fourths       => 0.75
-	"tf", // thirty fourtieths   => 0.75
-	"bf", // hundred thousandths => 0.1
+	{"df", 0, ActionMultiply, "fifteen twentieths => 0.75"},
+	{"sf", 0, ActionMultiply, "three fourths => 0.75"},
+	{"tf", 0, ActionMultiply, "thirty fourtieths => 0.75"},
+	{"bf", 0, ActionMultiply, "hundred thousandths => 0.1"},
 
 	// ordinals that could possibly be singluar fractions
-	"dD", // a tenth       => 0.1  || fifteen tenth     => 15 10th
-	"dS", // a fourth      => 0.25 || fifteen fourth    => 15 4th
-	"dT", // a twentieth   => 0.05 || fifteen twentieth => 15 20th
-	"dB", // a hundredth   => 0.01 || fifteen hundredth => 1500th
-	"sD", // one tenth     => 0.1  || two tenth         => 2 10th
-	"sS", // one fourth    => 0.25 || two fourth        => 2 4th
-	"sT", // one twentieth => 0.05 || two twentieth     => 15 20th
-	"sB", // one hundredth => 0.01 || fifteen hundredth => 1500th
+	{"dD", 0, ActionFractionOrDone, "a tenth => 0.1 || fifteen tenth => 15 10th"},
+	{"dS", 0, ActionFractionOrDone, "a fourth => 0.25 || fifteen fourth => 15 4th"},
+	{"dT", 0, ActionFractionOrDone, "a twentieth => 0.05 || fifteen twentieth => 15 20th"},
+	{"dB", 0, ActionFractionOrCombine, "a hundredth => 0.01 || fifteen hundredth => 1500th"},
+	{"sD", 0, ActionFractionOrDone, "one tenth => 0.1 || two tenth => 2 10th"},
+	{"sS", 0, ActionFractionOrDone, "one fourth => 0.25 || two fourth => 2 4th"},
+	{"sT", 0, ActionFractionOrDone, "one twentieth => 0.05 || two twentieth => 2 20th"},
+	{"sB", 0, ActionFractionOrCombine, "one hundredth => 0.01 || two hundredth => 200th"},
 
 	// all other ordinals
-	"tS", // twenty first       => 21st
-	"tB", // twenty thousandth  => 20000th
-	"bS", // hundred first      => 101st
-	"bB", // hundred thousandth => 100000th
+	{"tS", 0, ActionAdd, "twenty first => 21st"},
+	{"tB", 0, ActionMultiply, "twenty thousandth => 20000th"},
+	{"bS", 0, ActionAdd, "hundred first => 101st"},
+	{"bT", 0, ActionAdd, "hundred twentieth => 120th"},
+	{"bB", 0, ActionCombine, "hundred thousandth => 100000th"},
 
 	// glue
-	"d&f", // zero and a half      => 0.5
-	"s&f", // two and a half       => 2.5
-	"t&f", // twenty and a half    => 20.5
-	"b&f", // hundred and a half   => 100.5
-	"&",   // 100 and 50 => 100 50 => 150
-}
-
-var patternHandlers = map[string]patternHandler{
-	"tS": add,
-	"bS": add,
-
-	"df": multiply,
-	"sf": multiply,
-	"tf": multiply,
-	"bf": multiply,
-	"tB": multiply,
-	"bT": multiply,
-
-	"ts": combine,
-	"db": combine,
-	"sb": combine,
-	"tb": combine,
-	"bd": combine,
-	"bs": combine,
-	"bt": combine,
-	"bb": combine,
-	"bB": combine,
-
-	"bbb": combineToLowest,
-	"bdb": combineToLowest,
-	"bsb": combineToLowest,
-	"btb": combineToLowest,
-
-	"dd": yearOrDone,
-	"dt": yearOrDone,
-	"td": yearOrDone,
-
-	"dD": fractionOrDone,
-	"dS": fractionOrDone,
-	"dT": fractionOrDone,
-	"sD": fractionOrDone,
-	"sS": fractionOrDone,
-	"sT": fractionOrDone,
-
-	"dB": fractionOrCombine,
-	"sB": fractionOrCombine,
-
-	"d&f": addAnd,
-	"s&f": addAnd,
-	"t&f": addAnd,
-	"b&f": addAnd,
-
-	"&": drop,
+	{"d&f", 0, ActionAddAnd, "zero and a half => 0.5"},
+	{"s&f", 0, ActionAddAnd, "two and a half => 2.5"},
+	{"t&f", 0, ActionAddAnd, "twenty and a half => 20.5"},
+	{"b&f", 0, ActionAddAnd, "hundred and a half => 100.5"},
+	{"&", 0, ActionDrop, "100 and 50 => 100 50 => 150"},
+
+	// percentages
+	{"d%", 0, ActionPercent, "fifteen percent => 15%"},
+	{"s%", 0, ActionPercent, "five percent => 5%"},
+	{"t%", 0, ActionPercent, "twenty percent => 20%"},
+	{"b%", 0, ActionPercent, "hundred percent => 100%"},
+	{"f%", 0, ActionPercent, "twelve and a half % => 12.5%"},
+	{"/%", 0, ActionPercent, "1/2 percent => 0.5%"},
+})
+
+// Prioritize assigns increasing priorities to the rules in the order given,
+// leaving gaps so custom rules may be placed between them.
+func prioritize(rules []Rule) []Rule {
+	for i := range rules {
+		rules[i].Priority = (i + 1) * 10
+	}
+	return rules
 }
 
 // Done flags the number at the given index as "done" and no longer
@@ -125,8 +101,7 @@ func add(ns numbers, idx int) numbers {
 	a := ns[idx]
 	b := ns[idx+1]
 
-	ns[idx].numerator = a.numerator*b.denominator + a.denominator*b.numerator
-	ns[idx].denominator = a.denominator * b.denominator
+	ns[idx].numerator, ns[idx].denominator = sum(a, b)
 	ns[idx].typ = maxType(a.typ, b.typ)
 	ns[idx].ordinal = b.ordinal
 
@@ -145,8 +120,7 @@ func multiply(ns numbers, idx int) numbers {
 	a := ns[idx]
 	b := ns[idx+1]
 
-	ns[idx].numerator = a.numerator * b.numerator
-	ns[idx].denominator = a.denominator * b.denominator
+	ns[idx].numerator, ns[idx].denominator = product(a, b)
 	ns[idx].typ = maxType(a.typ, b.typ)
 	ns[idx].ordinal = b.ordinal
 
@@ -154,30 +128,39 @@ func multiply(ns numbers, idx int) numbers {
 }
 
 // Combine merges two numbers by addition or multiplication depending
-// on the relative values of the numbers
+// on the relative values of the numbers. A dozen always multiplies the number
+// before it (eg, "twenty dozen" => 240).
 func combine(ns numbers, idx int) numbers {
 	a := ns[idx]
 	b := ns[idx+1]
 
-	if a.numerator > b.numerator {
+	if a.numerator > b.numerator && !isDozen(b) {
 		return add(ns, idx)
 	}
 
 	return multiply(ns, idx)
 }
 
+// IsDozen returns true if the number is the multiplier "dozen", the only big
+// number smaller than one hundred.
+func isDozen(n number) bool {
+	return n.typ == numBig && n.numerator == 12 && n.denominator == 1
+}
+
 // CombineToLowest combines the two lowest adjacent values in a triple
 // of number values. This typically occurs when a number is sandwiched
 // between two large values.
 func combineToLowest(ns numbers, idx int) numbers {
-	l := ns[idx]
-	r := ns[idx+2]
+	return combine(ns, lowestPair(ns, idx))
+}
 
-	if l.numerator <= r.numerator {
-		return combine(ns, idx)
+// LowestPair returns the index of the lower pair of adjacent values in the
+// triple of number values starting at idx.
+func lowestPair(ns numbers, idx int) int {
+	if ns[idx].numerator <= ns[idx+2].numerator {
+		return idx
 	}
-
-	return combine(ns, idx+1)
+	return idx + 1
 }
 
 // YearOrDone potentially combines two double-digit consecutive values
@@ -186,7 +169,8 @@ func combineToLowest(ns numbers, idx int) numbers {
 // and does not include the first decade of each century (eg, 2000-2009). If the
 // heuristic isn't satisfied, the second number in the potential year is marked
 // as done to advance evaluation. Likewise, on successful conversion, the date
-// is marked done due to its semantic change (from arbitrary number to year).
+// is marked as a year, which is also done, due to its semantic change (from
+// arbitrary number to year).
 //
 // TODO: capture first decades with "oh": ninteen oh eight => 1908
 func yearOrDone(ns numbers, idx int) numbers {
@@ -196,12 +180,20 @@ func yearOrDone(ns numbers, idx int) numbers {
 	if a.numerator > 10 && a.numerator <= 20 && b.numerator >= 10 && b.numerator < 100 {
 		ns[idx].numerator *= 100
 		ns = add(ns, idx)
-		return done(ns, idx)
+		ns[idx].typ = numYear
+		return ns
 	}
 
 	return done(ns, idx+1)
 }
 
+// Percent converts a number into a percentage, dropping the percent sign that
+// follows it. The percentage is not combined with any other numbers.
+func percent(ns numbers, idx int) numbers {
+	ns[idx].typ = numPercent
+	return drop(ns, idx+1)
+}
+
 // FractionOr builds a patternHandler that converts ordinals to 1-numerator
 // fractions based on context: one hundredth => 0.001 vs. two hundredth => 200th
 // If the heuristic fails, the passed in patternHandler is applied instead.
@@ -143,7 +143,7 @@ func TestPatterns_YearOrDone(t *testing.T) {
 	out := yearOrDone(ns, 0)
 	assert.Len(t, out, 1)
 	assert.Equal(t, float64(1988), out[0].Value())
-	assert.Equal(t, numDone, out[0].typ)
+	assert.Equal(t, numYear, out[0].typ)
 
 	ns = numbers{
 		number{numerator: 20, denominator: 1, typ: numTens},
@@ -153,7 +153,7 @@ func TestPatterns_YearOrDone(t *testing.T) {
 	out = yearOrDone(ns, 0)
 	assert.Len(t, out, 1)
 	assert.Equal(t, float64(2015), out[0].Value())
-	assert.Equal(t, numDone, out[0].typ)
+	assert.Equal(t, numYear, out[0].typ)
 
 	ns = numbers{
 		number{numerator: 30, denominator: 1, typ: numTens},
@@ -237,8 +237,11 @@ func TestPatterns_AddAnd(t *testing.T) {
 func TestPatterns_AllHaveHandlers(t *testing.T) {
 	t.Parallel()
 
-	for _, p := range patterns {
-		_, ok := patternHandlers[p]
-		assert.True(t, ok, "no handler for pattern `%s`", p)
+	for _, r := range defaultRules {
+		assert.NotNil(t, r.Action.handler, "no handler for pattern `%s`", r.Pattern)
+		assert.NotEmpty(t, r.Description, "no description for pattern `%s`", r.Pattern)
 	}
+
+	_, err := NewRuleSet(defaultRules...)
+	assert.NoError(t, err)
 }
@@ -0,0 +1,97 @@
+package numwords
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNotPercent is returned by ParsePercent if the input is a number but not a
+// percentage.
+var ErrNotPercent = errors.New("the input is not a percentage")
+
+// PercentMode determines how a Parser reads percentages
+type PercentMode int8
+
+const (
+	// PercentIgnore does not read percentages, leaving words like "percent"
+	// and values like "50%" as they are.
+	PercentIgnore PercentMode = iota
+
+	// PercentValue reads percentages as the number of percent, so "fifty
+	// percent" is 50. ParseString writes it as "50%".
+	PercentValue
+
+	// PercentRatio reads percentages as their ratio, so "fifty percent" is
+	// 0.5. ParseString writes it as "50%".
+	PercentRatio
+)
+
+// percentWords are the case-insensitive words that follow a number to make it
+// a percentage. The tokenizer joins "per cent" into a single word.
+var percentWords = map[string]struct{}{
+	"%":        {},
+	"percent":  {},
+	"per cent": {},
+	"pct":      {},
+}
+
+// ParsePercent reads a percentage and converts it to its ratio (eg, "twelve
+// and a half per cent" => 0.125). ErrNotPercent is returned if the input is a
+// single number that is not a percentage.
+func ParsePercent(s string) (float64, error) {
+	return defaultParser.ParsePercent(s)
+}
+
+// ParsePercent reads a percentage and converts it to its ratio (eg, "twelve
+// and a half per cent" => 0.125). ErrNotPercent is returned if the input is a
+// single number that is not a percentage. Percentages are read regardless of
+// the parser's PercentMode.
+func (p *Parser) ParsePercent(s string) (float64, error) {
+	q := *p
+	q.percent = PercentRatio
+
+	ns, err := q.parse(s)
+	if err != nil {
+		return -1, err
+	}
+
+	if len(ns) == 1 && ns[0].typ != numPercent {
+		return -1, ErrNotPercent
+	}
+
+	return ns.ratios().Float()
+}
+
+// MaybePercent reads a percent sign following a number in the buffer, or a
+// number written with a percent sign (eg, "50%") if the parser is configured
+// to read percentages. The sign is not read after a number that cannot be a
+// percentage once reduced, such as an ordinal or a year (eg, "nineteen ten
+// percent").
+func (p *Parser) maybePercent(s string, buf numbers) (n number, ok bool) {
+	if p.percent == PercentIgnore {
+		return
+	}
+
+	if _, ok = percentWords[strings.ToLower(s)]; ok {
+		if len(buf) == 0 || buf[len(buf)-1].ordinal || buf[len(buf)-1].typ >= numPercentSign {
+			return n, false
+		}
+
+		reduced := p.evaluate(append(numbers(nil), buf...))
+		if last := reduced[len(reduced)-1]; last.ordinal || last.typ >= numPercentSign {
+			return n, false
+		}
+		return number{0, 1, numPercentSign, false}, true
+	}
+
+	if !strings.HasSuffix(s, "%") {
+		return
+	}
+
+	if n, ok = p.maybeNumeric(strings.TrimSuffix(s, "%")); !ok || n.ordinal {
+		return n, false
+	}
+
+	n.typ = numPercent
+	return n, true
+}
@@ -0,0 +1,74 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercent_ParsePercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out float64
+	}{
+		{"fifty percent", 0.5},
+		{"twelve and a half per cent", 0.125},
+		{"one hundred percent", 1},
+		{"twenty five %", 0.25},
+		{"50%", 0.5},
+		{"3/4 PERCENT", 0.0075},
+	}
+
+	for _, test := range tests {
+		f, err := ParsePercent(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, f, test.in)
+		}
+	}
+
+	_, err := ParsePercent("fifty")
+	assert.Equal(t, ErrNotPercent, err)
+
+	_, err = ParsePercent("fifty percent off")
+	assert.Equal(t, ErrNonNumber, err)
+
+	_, err = ParsePercent("percent")
+	assert.Equal(t, ErrNonNumber, err)
+
+	_, err = ParsePercent("fifty percent twenty percent")
+	assert.Equal(t, ErrManyNumbers, err)
+}
+
+func TestPercent_MaybePercent(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithPercentages(PercentValue))
+	num := numbers{{50, 1, numTens, false}}
+
+	n, ok := p.maybePercent("percent", num)
+	assert.True(t, ok)
+	assert.Equal(t, numPercentSign, n.typ)
+
+	_, ok = p.maybePercent("Per Cent", num)
+	assert.True(t, ok)
+
+	_, ok = p.maybePercent("percent", numbers{})
+	assert.False(t, ok, "no preceding number")
+
+	_, ok = p.maybePercent("percent", numbers{{2, 1, numSingleOrdinal, true}})
+	assert.False(t, ok, "preceded by an ordinal")
+
+	n, ok = p.maybePercent("12.5%", numbers{})
+	if assert.True(t, ok) {
+		assert.Equal(t, numPercent, n.typ)
+		assert.Equal(t, 12.5, n.Value())
+	}
+
+	_, ok = p.maybePercent("2nd%", numbers{})
+	assert.False(t, ok)
+
+	_, ok = defaultParser.maybePercent("percent", num)
+	assert.False(t, ok, "disabled by default")
+}
@@ -0,0 +1,281 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// Dimension is the kind of physical quantity measured by a unit
+type Dimension int8
+
+const (
+	// Mass is measured in grams
+	Mass Dimension = iota + 1
+
+	// Length is measured in metres
+	Length
+
+	// Volume is measured in litres
+	Volume
+
+	// Time is measured in seconds
+	Time
+)
+
+// Unit is a unit of measure
+type Unit struct {
+	// Name is the singular name of the unit (eg, "foot").
+	Name string
+
+	// Symbol is the abbreviation of the unit (eg, "ft").
+	Symbol string
+
+	// Dimension is the kind of quantity measured by the unit.
+	Dimension Dimension
+
+	// Factor is the size of the unit in the base unit of its dimension (eg,
+	// 0.3048 for a foot, measured in metres).
+	Factor *big.Rat
+
+	// Sub is the word for the smaller unit implied by a bare number following
+	// this unit, as in "six foot three". It is empty if no unit is implied.
+	Sub string
+}
+
+// UnitTable maps case-insensitive words, including plurals and symbols, to
+// the units they represent.
+type UnitTable map[string]Unit
+
+// Quantity is a number paired with a unit of measure
+type Quantity struct {
+	// Value is the exact number of units.
+	Value *big.Rat
+
+	// Unit is the unit of measure.
+	Unit Unit
+}
+
+var (
+	unitMilligram  = Unit{"milligram", "mg", Mass, decimal("0.001"), ""}
+	unitGram       = Unit{"gram", "g", Mass, decimal("1"), ""}
+	unitKilogram   = Unit{"kilogram", "kg", Mass, decimal("1000"), "gram"}
+	unitTonne      = Unit{"tonne", "t", Mass, decimal("1000000"), ""}
+	unitOunce      = Unit{"ounce", "oz", Mass, decimal("28.349523125"), ""}
+	unitPound      = Unit{"pound", "lb", Mass, decimal("453.59237"), "ounce"}
+	unitStone      = Unit{"stone", "st", Mass, decimal("6350.29318"), "pound"}
+	unitTon        = Unit{"ton", "ton", Mass, decimal("907184.74"), ""}
+	unitMillimetre = Unit{"millimetre", "mm", Length, decimal("0.001"), ""}
+	unitCentimetre = Unit{"centimetre", "cm", Length, decimal("0.01"), ""}
+	unitMetre      = Unit{"metre", "m", Length, decimal("1"), "centimetre"}
+	unitKilometre  = Unit{"kilometre", "km", Length, decimal("1000"), ""}
+	unitInch       = Unit{"inch", "in", Length, decimal("0.0254"), ""}
+	unitFoot       = Unit{"foot", "ft", Length, decimal("0.3048"), "inch"}
+	unitYard       = Unit{"yard", "yd", Length, decimal("0.9144"), ""}
+	unitMile       = Unit{"mile", "mi", Length, decimal("1609.344"), ""}
+	unitMillilitre = Unit{"millilitre", "ml", Volume, decimal("0.001"), ""}
+	unitLitre      = Unit{"litre", "l", Volume, decimal("1"), ""}
+	unitTeaspoon   = Unit{"teaspoon", "tsp", Volume, decimal("0.00492892159375"), ""}
+	unitTablespoon = Unit{"tablespoon", "tbsp", Volume, decimal("0.01478676478125"), ""}
+	unitCup        = Unit{"cup", "cup", Volume, decimal("0.2365882365"), ""}
+	unitPint       = Unit{"pint", "pt", Volume, decimal("0.473176473"), ""}
+	unitQuart      = Unit{"quart", "qt", Volume, decimal("0.946352946"), ""}
+	unitGallon     = Unit{"gallon", "gal", Volume, decimal("3.785411784"), ""}
+	unitMillisec   = Unit{"millisecond", "ms", Time, decimal("0.001"), ""}
+	unitSecond     = Unit{"second", "s", Time, decimal("1"), ""}
+	unitMinute     = Unit{"minute", "min", Time, decimal("60"), "second"}
+	unitHour       = Unit{"hour", "h", Time, decimal("3600"), "minute"}
+	unitDay        = Unit{"day", "d", Time, decimal("86400"), ""}
+	unitWeek       = Unit{"week", "wk", Time, decimal("604800"), ""}
+)
+
+// DefaultUnits are the units of mass, length, volume and time read by
+// default. Ambiguous symbols, such as "in" for inches, are not included.
+var DefaultUnits = UnitTable{
+	"milligram": unitMilligram, "milligrams": unitMilligram, "mg": unitMilligram,
+	"gram": unitGram, "grams": unitGram, "g": unitGram,
+	"kilogram": unitKilogram, "kilograms": unitKilogram, "kilo": unitKilogram, "kilos": unitKilogram, "kg": unitKilogram,
+	"tonne": unitTonne, "tonnes": unitTonne,
+	"ounce": unitOunce, "ounces": unitOunce, "oz": unitOunce,
+	"pound": unitPound, "pounds": unitPound, "lb": unitPound, "lbs": unitPound,
+	"stone": unitStone, "stones": unitStone,
+	"ton": unitTon, "tons": unitTon,
+
+	"millimetre": unitMillimetre, "millimetres": unitMillimetre, "millimeter": unitMillimetre, "millimeters": unitMillimetre, "mm": unitMillimetre,
+	"centimetre": unitCentimetre, "centimetres": unitCentimetre, "centimeter": unitCentimetre, "centimeters": unitCentimetre, "cm": unitCentimetre,
+	"metre": unitMetre, "metres": unitMetre, "meter": unitMetre, "meters": unitMetre,
+	"kilometre": unitKilometre, "kilometres": unitKilometre, "kilometer": unitKilometre, "kilometers": unitKilometre, "km": unitKilometre,
+	"inch": unitInch, "inches": unitInch,
+	"foot": unitFoot, "feet": unitFoot, "ft": unitFoot,
+	"yard": unitYard, "yards": unitYard, "yd": unitYard,
+	"mile": unitMile, "miles": unitMile,
+
+	"millilitre": unitMillilitre, "millilitres": unitMillilitre, "milliliter": unitMillilitre, "milliliters": unitMillilitre, "ml": unitMillilitre,
+	"litre": unitLitre, "litres": unitLitre, "liter": unitLitre, "liters": unitLitre,
+	"teaspoon": unitTeaspoon, "teaspoons": unitTeaspoon, "tsp": unitTeaspoon,
+	"tablespoon": unitTablespoon, "tablespoons": unitTablespoon, "tbsp": unitTablespoon,
+	"cup": unitCup, "cups": unitCup,
+	"pint": unitPint, "pints": unitPint,
+	"quart": unitQuart, "quarts": unitQuart,
+	"gallon": unitGallon, "gallons": unitGallon, "gal": unitGallon,
+
+	"millisecond": unitMillisec, "milliseconds": unitMillisec, "ms": unitMillisec,
+	"second": unitSecond, "seconds": unitSecond, "sec": unitSecond, "secs": unitSecond,
+	"minute": unitMinute, "minutes": unitMinute, "min": unitMinute, "mins": unitMinute,
+	"hour": unitHour, "hours": unitHour, "hr": unitHour, "hrs": unitHour,
+	"day": unitDay, "days": unitDay,
+	"week": unitWeek, "weeks": unitWeek,
+}
+
+// decimal converts a decimal string into an exact fraction
+func decimal(s string) *big.Rat {
+	r, _ := new(big.Rat).SetString(s)
+	return r
+}
+
+// String writes the quantity with its unit symbol (eg, "75 in").
+func (q Quantity) String() string {
+	s := q.Value.FloatString(6)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return s + " " + q.Unit.Symbol
+}
+
+// ExtractQuantities finds all quantities with a unit of measure in the text
+// string (eg, "two and a half kilograms" or "three quarters of a mile").
+// Composite measurements are combined into their smallest unit, so "six foot
+// three inches" is 75 inches.
+func ExtractQuantities(s string) []Quantity {
+	return defaultParser.ExtractQuantities(s)
+}
+
+// ExtractQuantities finds all quantities with a unit of measure in the text
+// string (eg, "two and a half kilograms" or "three quarters of a mile").
+// Composite measurements are combined into their smallest unit, so "six foot
+// three inches" is 75 inches.
+func (p *Parser) ExtractQuantities(s string) (out []Quantity) {
+	in := p.splitUnits(p.explode(s))
+
+	for i := 0; i < len(in); {
+		if q, end, ok := p.matchQuantity(in, i); ok {
+			out = append(out, q)
+			i = end
+		} else {
+			i++
+		}
+	}
+
+	return
+}
+
+// MatchQuantity attempts to read a quantity starting at the ith word,
+// returning it along with the index of the first word after it. A unit preceded
+// by an article is one of that unit (eg, "an hour").
+func (p *Parser) matchQuantity(in []string, i int) (q Quantity, end int, ok bool) {
+	if p.articles && isArticle(in[i]) {
+		if q.Unit, ok = p.unit(in, i+1); ok {
+			q.Value = big.NewRat(1, 1)
+			return q, p.readSubQuantities(in, i+2, &q), true
+		}
+	}
+
+	ns, j := p.readNumbers(in, i, p.isUnit)
+	if len(ns) == 0 {
+		return q, i, false
+	}
+
+	n := ns[len(ns)-1]
+	if n.ordinal || n.typ == numPercent || n.overflowed() {
+		return q, i, false
+	}
+
+	j = skipArticle(in, j)
+	if q.Unit, ok = p.unit(in, j); !ok {
+		return q, i, false
+	}
+
+	q.Value = big.NewRat(int64(n.numerator), int64(n.denominator))
+	return q, p.readSubQuantities(in, j+1, &q), true
+}
+
+// ReadSubQuantities reads any smaller units following a quantity, such as
+// "three inches" in "six foot three inches", adding them to the quantity. The
+// index of the first word after them is returned.
+func (p *Parser) readSubQuantities(in []string, i int, q *Quantity) int {
+	for i < len(in) {
+		j := i
+		if strings.EqualFold(in[j], "and") {
+			j++
+		}
+
+		ns, k := p.readNumbers(in, j, p.isUnit)
+		if len(ns) != 1 || ns[0].ordinal || ns[0].typ == numPercent || ns[0].overflowed() {
+			break
+		}
+
+		sub, ok := p.unit(in, k)
+		if ok {
+			k++
+		} else if sub, ok = p.units[q.Unit.Sub]; !ok || j > i {
+			break
+		}
+
+		if sub.Dimension != q.Unit.Dimension || sub.Factor.Cmp(q.Unit.Factor) >= 0 {
+			break
+		}
+
+		scale := new(big.Rat).Quo(q.Unit.Factor, sub.Factor)
+		q.Value.Mul(q.Value, scale)
+		q.Value.Add(q.Value, big.NewRat(int64(ns[0].numerator), int64(ns[0].denominator)))
+		q.Unit = sub
+		i = k
+	}
+
+	return i
+}
+
+// IsUnit returns true if the word is a unit of measure
+func (p *Parser) isUnit(s string) bool {
+	_, ok := p.units[strings.ToLower(s)]
+	return ok
+}
+
+// Unit returns the unit of measure for the ith word, if any
+func (p *Parser) unit(in []string, i int) (u Unit, ok bool) {
+	if i < len(in) {
+		u, ok = p.units[strings.ToLower(in[i])]
+	}
+	return
+}
+
+// SkipArticle skips over "of a", "of an", "a" or "an" between a number and its
+// unit (eg, "three quarters of a mile"), returning the index of the next word.
+func skipArticle(in []string, i int) int {
+	if i < len(in) && strings.EqualFold(in[i], "of") {
+		i++
+	}
+
+	if i < len(in) && (strings.EqualFold(in[i], "a") || strings.EqualFold(in[i], "an")) {
+		i++
+	}
+
+	return i
+}
+
+// SplitUnits splits unit symbols from numbers written with digits (eg, "5kg"
+// => "5" "kg").
+func (p *Parser) splitUnits(in []string) []string {
+	out := make([]string, 0, len(in))
+
+	for _, s := range in {
+		idx := strings.IndexFunc(s, unicode.IsLetter)
+		if idx > 0 && p.isUnit(s[idx:]) {
+			if _, ok := p.maybeNumeric(s[:idx]); ok {
+				out = append(out, s[:idx], s[idx:])
+				continue
+			}
+		}
+		out = append(out, s)
+	}
+
+	return out
+}
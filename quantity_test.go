@@ -0,0 +1,92 @@
+package numwords
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuantity_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "75 in", Quantity{big.NewRat(75, 1), unitInch}.String())
+	assert.Equal(t, "2.5 kg", Quantity{big.NewRat(5, 2), unitKilogram}.String())
+	assert.Equal(t, "0.333333 cup", Quantity{big.NewRat(1, 3), unitCup}.String())
+	assert.Equal(t, "10 mi", Quantity{big.NewRat(10, 1), unitMile}.String())
+}
+
+func TestQuantity_ExtractQuantities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"two and a half kilograms", []string{"2.5 kg"}},
+		{"three quarters of a mile", []string{"0.75 mi"}},
+		{"six foot three", []string{"75 in"}},
+		{"six foot three inches tall", []string{"75 in"}},
+		{"he is 6 ft 3 in", []string{"75 in"}},
+		{"one hour twenty minutes", []string{"80 min"}},
+		{"two hours and thirty minutes", []string{"150 min"}},
+		{"a minute", []string{"1 min"}},
+		{"an hour and ten minutes", []string{"70 min"}},
+		{"a cat", nil},
+		{"one second", []string{"1 s"}},
+		{"5kg of flour and 2 cups of sugar", []string{"5 kg", "2 cup"}},
+		{"ten KM", []string{"10 km"}},
+		{"one metre eighty", []string{"180 cm"}},
+		{"a pound and three apples", []string{"1 lb"}},
+		{"two inches three feet", []string{"2 in", "3 ft"}},
+		{"three apples", nil},
+		{"the second mile", nil},
+		{"miles", nil},
+	}
+
+	for _, test := range tests {
+		var actual []string
+		for _, q := range ExtractQuantities(test.in) {
+			actual = append(actual, q.String())
+		}
+		assert.Equal(t, test.expected, actual, test.in)
+	}
+
+	q := ExtractQuantities("six foot three")
+	if assert.Len(t, q, 1) {
+		assert.Equal(t, "inch", q[0].Unit.Name)
+		assert.Equal(t, Length, q[0].Unit.Dimension)
+		assert.Equal(t, big.NewRat(75, 1), q[0].Value)
+	}
+}
+
+func TestQuantity_WithUnits(t *testing.T) {
+	t.Parallel()
+
+	furlong := Unit{"furlong", "fur", Length, decimal("201.168"), ""}
+	p := New(WithUnits(UnitTable{"furlong": furlong, "furlongs": furlong}))
+
+	q := p.ExtractQuantities("seven furlongs and two miles")
+	if assert.Len(t, q, 1) {
+		assert.Equal(t, "7 fur", q[0].String())
+	}
+}
+
+func TestQuantity_SplitUnits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       []string
+		expected []string
+	}{
+		{[]string{"5kg"}, []string{"5", "kg"}},
+		{[]string{"2.5km"}, []string{"2.5", "km"}},
+		{[]string{"1st"}, []string{"1st"}},
+		{[]string{"kg"}, []string{"kg"}},
+		{[]string{"x5kg"}, []string{"x5kg"}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, defaultParser.splitUnits(test.in), "%v", test.in)
+	}
+}
@@ -0,0 +1,146 @@
+package numwords
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// ErrInvalidRange is returned by ParseRange if the input is not a number or a
+// range of numbers.
+var ErrInvalidRange = errors.New("the input is not a valid range")
+
+// Range is a span of numbers read from text. Open-ended ranges (eg, "over
+// fifty") use an infinite Min or Max.
+type Range struct {
+	Min, Max float64
+
+	// Approximate is true if the range was hedged (eg, "about fifty").
+	Approximate bool
+}
+
+// hedge is a phrase preceding a number or range that makes it approximate or
+// open-ended.
+type hedge struct {
+	words    []string
+	min, max bool // whether or not the bound is kept
+}
+
+// hedges are the case-insensitive phrases that may precede a range
+var hedges = []hedge{
+	{[]string{"about"}, true, true},
+	{[]string{"around"}, true, true},
+	{[]string{"roughly"}, true, true},
+	{[]string{"approximately"}, true, true},
+	{[]string{"nearly"}, true, true},
+	{[]string{"almost"}, true, true},
+	{[]string{"over"}, true, false},
+	{[]string{"more", "than"}, true, false},
+	{[]string{"at", "least"}, true, false},
+	{[]string{"under"}, false, true},
+	{[]string{"less", "than"}, false, true},
+	{[]string{"at", "most"}, false, true},
+	{[]string{"up", "to"}, false, true},
+}
+
+// rangeWords separate the bounds of a range (eg, "twenty to thirty")
+var rangeWords = map[string]struct{}{
+	"to":      {},
+	"through": {},
+	"thru":    {},
+}
+
+// rangeDashes are the dashes that separate the bounds of a range (eg, "5–10").
+// They are replaced before the tokenizer normalizes them into hyphens.
+var rangeDashes = strings.NewReplacer("\u2013", " to ", "\u2014", " to ")
+
+// ParseRange reads a number or range of numbers, such as "between five and
+// ten", "twenty to thirty" or "about fifty". Hedges such as "about" or "over"
+// mark the range approximate, with "over", "under" and the like leaving one
+// bound open. ErrInvalidRange is returned if the input is not a valid range.
+func ParseRange(s string) (Range, error) {
+	return defaultParser.ParseRange(s)
+}
+
+// ParseRange reads a number or range of numbers, such as "between five and
+// ten", "twenty to thirty" or "about fifty". Hedges such as "about" or "over"
+// mark the range approximate, with "over", "under" and the like leaving one
+// bound open. ErrInvalidRange is returned if the input is not a valid range.
+func (p *Parser) ParseRange(s string) (r Range, err error) {
+	in := p.explode(strings.ToLower(rangeDashes.Replace(s)))
+
+	h, ok := matchHedge(in)
+	if ok {
+		in = in[len(h.words):]
+	}
+
+	if r, err = p.bounds(in); err != nil {
+		return r, err
+	}
+
+	if ok {
+		r.Approximate = true
+		if !h.min {
+			r.Min = math.Inf(-1)
+		}
+		if !h.max {
+			r.Max = math.Inf(1)
+		}
+	}
+
+	return r, nil
+}
+
+// Bounds reads the words as a single number or a range of two numbers
+// separated by "and" (after "between") or "to" (optionally after "from"). The
+// last separator is tried first, so "between one hundred and five and two
+// hundred" is 105 to 200.
+func (p *Parser) bounds(in []string) (Range, error) {
+	if min, ok := p.value(in); ok {
+		return Range{Min: min, Max: min}, nil
+	}
+
+	sep := rangeWords
+	if len(in) > 0 && in[0] == "between" {
+		in, sep = in[1:], map[string]struct{}{"and": {}}
+	} else if len(in) > 0 && in[0] == "from" {
+		in = in[1:]
+	}
+
+	for i := len(in) - 2; i > 0; i-- {
+		if _, ok := sep[in[i]]; !ok {
+			continue
+		}
+
+		min, ok := p.value(in[:i])
+		if !ok {
+			continue
+		}
+
+		if max, ok := p.value(in[i+1:]); ok && min <= max {
+			return Range{Min: min, Max: max}, nil
+		}
+	}
+
+	return Range{}, ErrInvalidRange
+}
+
+// Value reads the words as a single number
+func (p *Parser) value(in []string) (float64, bool) {
+	ns, i := p.readNumbers(in, 0, nil)
+	if len(in) == 0 || i != len(in) || len(ns) != 1 || ns[0].ordinal {
+		return -1, false
+	}
+
+	return p.ratios(ns)[0].Value(), true
+}
+
+// MatchHedge returns the hedge at the start of the words, if any
+func matchHedge(in []string) (hedge, bool) {
+	for _, h := range hedges {
+		if len(in) > len(h.words) && strings.Join(in[:len(h.words)], " ") == strings.Join(h.words, " ") {
+			return h, true
+		}
+	}
+	return hedge{}, false
+}
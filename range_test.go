@@ -0,0 +1,76 @@
+package numwords
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange_ParseRange(t *testing.T) {
+	t.Parallel()
+
+	inf := math.Inf(1)
+
+	tests := []struct {
+		in  string
+		out Range
+	}{
+		{"fifty", Range{50, 50, false}},
+		{"between five and ten", Range{5, 10, false}},
+		{"between one hundred and five and two hundred", Range{105, 200, false}},
+		{"between one hundred and two hundred", Range{100, 200, false}},
+		{"between one hundred and two hundred and five", Range{100, 205, false}},
+		{"twenty to thirty", Range{20, 30, false}},
+		{"5\u201310", Range{5, 10, false}},
+		{"five \u2014 ten", Range{5, 10, false}},
+		{"from 20 to 30", Range{20, 30, false}},
+		{"two through four", Range{2, 4, false}},
+		{"one and a half to two", Range{1.5, 2, false}},
+		{"about fifty", Range{50, 50, true}},
+		{"Around twenty to thirty", Range{20, 30, true}},
+		{"roughly between five and ten", Range{5, 10, true}},
+		{"nearly a hundred", Range{100, 100, true}},
+		{"over fifty", Range{50, inf, true}},
+		{"at least three", Range{3, inf, true}},
+		{"under ten", Range{-inf, 10, true}},
+		{"less than ten", Range{-inf, 10, true}},
+	}
+
+	for _, test := range tests {
+		r, err := ParseRange(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, r, test.in)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"about",
+		"foo",
+		"ten to twenty foo",
+		"thirty to twenty",
+		"between five",
+		"between five to ten",
+		"the third to the fifth",
+	}
+
+	for _, in := range invalid {
+		_, err := ParseRange(in)
+		assert.Equal(t, ErrInvalidRange, err, in)
+	}
+}
+
+func TestRange_MatchHedge(t *testing.T) {
+	t.Parallel()
+
+	h, ok := matchHedge([]string{"at", "least", "five"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"at", "least"}, h.words)
+
+	_, ok = matchHedge([]string{"at", "least"})
+	assert.False(t, ok, "no number follows")
+
+	_, ok = matchHedge([]string{"five"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,89 @@
+package numwords
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRomanRange is returned by FormatRoman if the value cannot be written as
+// a standard roman numeral.
+var ErrRomanRange = errors.New("roman numerals must be between 1 and 3999")
+
+// romanDigits lists the roman numeral symbols, including the subtractive
+// pairs, in descending order of value.
+var romanDigits = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"},
+	{900, "CM"},
+	{500, "D"},
+	{400, "CD"},
+	{100, "C"},
+	{90, "XC"},
+	{50, "L"},
+	{40, "XL"},
+	{10, "X"},
+	{9, "IX"},
+	{5, "V"},
+	{4, "IV"},
+	{1, "I"},
+}
+
+// romanStopWords are canonical roman numerals that more often appear in text
+// as words or abbreviations (eg, "MIX" or "CD").
+var romanStopWords = map[string]struct{}{
+	"CC": {}, "CD": {}, "CI": {}, "CIV": {}, "CL": {}, "CM": {}, "CV": {},
+	"DC": {}, "DI": {}, "DIV": {}, "DIX": {}, "DL": {}, "LI": {}, "LIV": {},
+	"MC": {}, "MD": {}, "MI": {}, "MIX": {}, "ML": {}, "MM": {}, "XL": {},
+	"XXX": {},
+}
+
+// FormatRoman writes the integer as an uppercase roman numeral (eg, 14 =>
+// "XIV"). An error is returned if the value is not between 1 and 3999.
+func FormatRoman(i int) (string, error) {
+	if i < 1 || i > 3999 {
+		return "", ErrRomanRange
+	}
+
+	var b strings.Builder
+	for _, d := range romanDigits {
+		for ; i >= d.value; i -= d.value {
+			b.WriteString(d.symbol)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// MaybeRoman reads an uppercase roman numeral in its canonical form (eg,
+// "XIV", but not "XIIII") if the parser is configured to do so. Single letters
+// and common words that happen to be valid numerals are ignored to avoid false
+// positives such as "I" or "MIX".
+func (p *Parser) maybeRoman(s string) (n number, ok bool) {
+	if !p.roman || len(s) < 2 {
+		return
+	}
+
+	if _, ok = romanStopWords[s]; ok {
+		return n, false
+	}
+
+	i, rest := 0, s
+	for _, d := range romanDigits {
+		for strings.HasPrefix(rest, d.symbol) {
+			i += d.value
+			rest = rest[len(d.symbol):]
+		}
+	}
+
+	if rest != "" {
+		return n, false
+	}
+
+	if canonical, err := FormatRoman(i); err != nil || canonical != s {
+		return n, false
+	}
+
+	return number{i, 1, classify(i, false), false}, true
+}
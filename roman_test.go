@@ -0,0 +1,80 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoman_FormatRoman(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  int
+		out string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{16, "XVI"},
+		{40, "XL"},
+		{57, "LVII"},
+		{1990, "MCMXC"},
+		{2024, "MMXXIV"},
+		{3999, "MMMCMXCIX"},
+	}
+
+	for _, test := range tests {
+		s, err := FormatRoman(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, s, test.in)
+		}
+	}
+
+	_, err := FormatRoman(0)
+	assert.Equal(t, ErrRomanRange, err)
+
+	_, err = FormatRoman(4000)
+	assert.Equal(t, ErrRomanRange, err)
+}
+
+func TestRoman_MaybeRoman(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithRomanNumerals(true))
+
+	tests := []struct {
+		in  string
+		val float64
+		typ numberType
+		ok  bool
+	}{
+		{"XIV", 14, numDirect, true},
+		{"XVI", 16, numDirect, true},
+		{"LVII", 57, numTens, true},
+		{"IV", 4, numSingle, true},
+		{"MCMXC", 1990, numBig, true},
+		{in: "I", ok: false},
+		{in: "V", ok: false},
+		{in: "MIX", ok: false},
+		{in: "CD", ok: false},
+		{in: "IIII", ok: false},
+		{in: "IC", ok: false},
+		{in: "VX", ok: false},
+		{in: "xiv", ok: false},
+		{in: "CIVIL", ok: false},
+		{in: "", ok: false},
+	}
+
+	for _, test := range tests {
+		n, ok := p.maybeRoman(test.in)
+		if assert.Equal(t, test.ok, ok, test.in) && ok {
+			assert.Equal(t, test.val, n.Value(), test.in)
+			assert.Equal(t, test.typ, n.typ, test.in)
+		}
+	}
+
+	_, ok := defaultParser.maybeRoman("XIV")
+	assert.False(t, ok, "disabled by default")
+}
@@ -0,0 +1,78 @@
+package numwords
+
+import "errors"
+
+// ErrNotInteger is returned by ParseInt, and by ParseAs and ParseAsWith for
+// integer types, with RoundReject if the number is not a whole number.
+var ErrNotInteger = errors.New("the number is not an integer")
+
+// Rounding determines how ParseInt and ParseAs convert numbers that are not
+// whole to integers. Numbers are rounded exactly from their fractions, not via
+// a float.
+type Rounding int8
+
+const (
+	// RoundTruncate drops any fractional portion, rounding toward zero (eg,
+	// "twelve and a half" => 12).
+	RoundTruncate Rounding = iota
+
+	// RoundFloor rounds down, toward negative infinity.
+	RoundFloor
+
+	// RoundCeil rounds up, toward positive infinity (eg, "two thirds" => 1).
+	RoundCeil
+
+	// RoundHalfUp rounds to the nearest integer, with halves rounded away from
+	// zero (eg, "twelve and a half" => 13).
+	RoundHalfUp
+
+	// RoundHalfEven rounds to the nearest integer, with halves rounded to the
+	// even neighbor (eg, "twelve and a half" => 12, "thirteen and a half" =>
+	// 14).
+	RoundHalfEven
+
+	// RoundReject does not round, returning ErrNotInteger if the number is not
+	// a whole number.
+	RoundReject
+)
+
+// Round returns the integer value of the number with the rounding mode
+func (n number) round(mode Rounding) (int, error) {
+	num, den := n.numerator, n.denominator
+	if den < 0 {
+		num, den = -num, -den
+	}
+
+	q, r := num/den, num%den
+	if r == 0 {
+		return q, nil
+	}
+
+	sign, abs := 1, r
+	if r < 0 {
+		sign, abs = -1, -r
+	}
+
+	switch mode {
+	case RoundFloor:
+		if sign < 0 {
+			q--
+		}
+	case RoundCeil:
+		if sign > 0 {
+			q++
+		}
+	case RoundHalfUp:
+		if abs >= den-abs {
+			q += sign
+		}
+	case RoundHalfEven:
+		if abs > den-abs || (abs == den-abs && q%2 != 0) {
+			q += sign
+		}
+	case RoundReject:
+		return 0, ErrNotInteger
+	}
+
+	return q, nil
+}
@@ -0,0 +1,47 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRounding_Round(t *testing.T) {
+	t.Parallel()
+
+	modes := []Rounding{RoundTruncate, RoundFloor, RoundCeil, RoundHalfUp, RoundHalfEven}
+
+	tests := []struct {
+		num, den int
+		out      []int // by mode, in the order above
+	}{
+		{25, 2, []int{12, 12, 13, 13, 12}},
+		{27, 2, []int{13, 13, 14, 14, 14}},
+		{2, 3, []int{0, 0, 1, 1, 1}},
+		{1, 3, []int{0, 0, 1, 0, 0}},
+		{12, 1, []int{12, 12, 12, 12, 12}},
+		{-25, 2, []int{-12, -13, -12, -13, -12}},
+		{-27, 2, []int{-13, -14, -13, -14, -14}},
+		{-2, 3, []int{0, -1, 0, -1, -1}},
+		{25, -2, []int{-12, -13, -12, -13, -12}},
+		{9007199254740993, 2, []int{4503599627370496, 4503599627370496, 4503599627370497, 4503599627370497, 4503599627370496}},
+	}
+
+	for _, test := range tests {
+		n := number{test.num, test.den, numFraction, false}
+		for i, mode := range modes {
+			out, err := n.round(mode)
+			if assert.NoError(t, err) {
+				assert.Equal(t, test.out[i], out, "%d/%d mode %d", test.num, test.den, mode)
+			}
+		}
+	}
+
+	_, err := number{25, 2, numFraction, false}.round(RoundReject)
+	assert.Equal(t, ErrNotInteger, err)
+
+	out, err := number{24, 2, numFraction, false}.round(RoundReject)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 12, out)
+	}
+}
@@ -0,0 +1,280 @@
+package numwords
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidRule is returned by NewRuleSet if a rule is malformed or can never
+// be applied.
+var ErrInvalidRule = errors.New("invalid rule")
+
+// Rule describes a pattern of adjacent numbers and the Action that reduces
+// them, such as "one hundred" => 100.
+type Rule struct {
+	// Pattern is the sequence of number types matched by the rule, one letter
+	// per number:
+	//
+	//   &  the glue word "and"
+	//   d  zero, "a" and ten through nineteen (eg, "eleven")
+	//   s  one through nine (eg, "three")
+	//   t  multiples of ten from twenty to ninety (eg, "forty")
+	//   b  multipliers and numbers of one hundred or more (eg, "thousand")
+	//   f  fractions (eg, "halves" or "2.5")
+	//   /  fractions written with a slash (eg, "1/2")
+	//   D  ordinals of the d type (eg, "tenth")
+	//   S  ordinals of the s type (eg, "third")
+	//   T  ordinals of the t type (eg, "fortieth")
+	//   B  ordinals of the b type (eg, "thousandth")
+	//   %  the percent sign or word following a number
+	//   p  percentages
+	Pattern string
+
+	// Priority orders the rules; those with a lower priority are tried first.
+	// Rules with equal priority are tried in the order given.
+	Priority int
+
+	// Action reduces the numbers matched by the pattern.
+	Action Action
+
+	// Description documents the rule with an example (eg, "one hundred =>
+	// 100").
+	Description string
+}
+
+// Action reduces the numbers matched by a Rule. The predefined actions are
+// provided as package variables, and others may be created with NewAction.
+type Action struct {
+	name    string
+	handler patternHandler
+	arity   int // the minimum number of numbers matched
+}
+
+// Term is a number matched by a Rule, as passed to the function of an action
+// created with NewAction.
+type Term struct {
+	// Numerator and Denominator are the exact value of the number.
+	Numerator, Denominator int
+
+	// Type is the letter of the number's type in a Rule's Pattern (eg, "s"),
+	// or "_" if the number is done and is no longer matched by any rule.
+	Type string
+
+	// Ordinal is true if the number is an ordinal (eg, "third").
+	Ordinal bool
+}
+
+// NewAction creates an Action that calls fn with the first arity numbers
+// matched by a Rule, replacing them with the terms it returns, of which any
+// beyond the first arity are dropped. For example, an
+// action with an arity of 2 that returns the sum of the two terms as a single
+// "d" term reduces "ten three" to 13. The action must change the pattern of
+// the numbers, by combining them or marking one done with the type "_", so that
+// reduction ends. If it does not, the first number is marked done.
+func NewAction(name string, arity int, fn func(terms []Term) []Term) Action {
+	return Action{name, func(ns numbers, idx int) numbers {
+		matched := ns[idx : idx+arity]
+
+		terms := make([]Term, arity)
+		for i, n := range matched {
+			terms[i] = newTerm(n)
+		}
+
+		replaced := fn(terms)
+		if len(replaced) > arity {
+			replaced = replaced[:arity]
+		}
+
+		out := make(numbers, 0, len(ns))
+		for _, t := range replaced {
+			out = append(out, t.number(matched))
+		}
+		if out.pattern() == matched.pattern() {
+			out[0].typ = numDone
+		}
+
+		out = append(out, ns[idx+arity:]...)
+		return append(ns[:idx], out...)
+	}, arity}
+}
+
+// newTerm converts an internal number to a Term
+func newTerm(n number) Term {
+	return Term{n.numerator, n.denominator, n.typ.String(), n.ordinal}
+}
+
+// Number converts the term to an internal number, keeping the number it was
+// created from if it is unchanged. An unknown type is treated as done.
+func (t Term) number(matched numbers) number {
+	for _, n := range matched {
+		if newTerm(n) == t {
+			return n
+		}
+	}
+
+	typ := numDone
+	for nt, s := range typeStrings {
+		if s == t.Type {
+			typ = nt
+		}
+	}
+	return number{t.Numerator, t.Denominator, typ, t.Ordinal}
+}
+
+// String returns the name of the action
+func (a Action) String() string {
+	return a.name
+}
+
+// Merged returns the index of the number that is merged into the one before it
+// if the action removes any numbers when applied at idx.
+func (a Action) merged(ns numbers, idx int) int {
+	switch a.name {
+	case ActionDrop.name:
+		return idx
+	case ActionCombineToLowest.name:
+		return lowestPair(ns, idx) + 1
+	}
+	return idx + 1
+}
+
+var (
+	// ActionAdd adds the first two matched numbers (eg, "hundred first" =>
+	// 101st).
+	ActionAdd = Action{"add", add, 2}
+
+	// ActionMultiply multiplies the first two matched numbers (eg, "three
+	// fourths" => 0.75).
+	ActionMultiply = Action{"multiply", multiply, 2}
+
+	// ActionCombine adds the first two matched numbers if the first is larger,
+	// otherwise they are multiplied (eg, "hundred one" => 101, but "one
+	// hundred" => 100).
+	ActionCombine = Action{"combine", combine, 2}
+
+	// ActionCombineToLowest combines the smaller two of three matched numbers
+	// (eg, "thousand two hundred" => "thousand" 200).
+	ActionCombineToLowest = Action{"combineToLowest", combineToLowest, 3}
+
+	// ActionYearOrDone joins two matched numbers into a year if they appear to
+	// be one (eg, "nineteen eighty" => 1980), otherwise the second is left as
+	// it is.
+	ActionYearOrDone = Action{"yearOrDone", yearOrDone, 2}
+
+	// ActionFractionOrDone converts an ordinal after "one" into a fraction
+	// (eg, "one tenth" => 0.1), otherwise the first number is left as it is.
+	ActionFractionOrDone = Action{"fractionOrDone", fractionOrDone, 2}
+
+	// ActionFractionOrCombine converts an ordinal after "one" into a fraction
+	// (eg, "one hundredth" => 0.01), otherwise the numbers are combined.
+	ActionFractionOrCombine = Action{"fractionOrCombine", fractionOrCombine, 2}
+
+	// ActionAddAnd adds the numbers on either side of "and" (eg, "two and a
+	// half" => 2.5).
+	ActionAddAnd = Action{"addAnd", addAnd, 3}
+
+	// ActionDrop removes the first matched number (eg, a stray "and").
+	ActionDrop = Action{"drop", drop, 1}
+
+	// ActionDone leaves the first matched number as it is.
+	ActionDone = Action{"done", done, 1}
+
+	// ActionPercent makes the first matched number a percentage, removing the
+	// percent sign that follows it (eg, "five percent" => 5%).
+	ActionPercent = Action{"percent", percent, 2}
+)
+
+// RuleSet is a validated, ordered set of rules used by a Parser to reduce
+// numbers.
+type RuleSet struct {
+	rules []Rule
+}
+
+// defaultRuleSet is the RuleSet used by parsers by default
+var defaultRuleSet = mustRuleSet(DefaultRules()...)
+
+// DefaultRules returns a copy of the rules used by default, which may be
+// extended and passed to NewRuleSet.
+func DefaultRules() []Rule {
+	return append([]Rule(nil), defaultRules...)
+}
+
+// NewRuleSet orders the rules by priority and validates them. An error
+// wrapping ErrInvalidRule is returned if a rule has an invalid pattern, has no
+// action or one that needs more numbers than the pattern matches, or can never
+// be applied because the pattern of a rule before it is always matched first.
+func NewRuleSet(rules ...Rule) (*RuleSet, error) {
+	sorted := append([]Rule(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	for i, r := range sorted {
+		if r.Pattern == "" || strings.Trim(r.Pattern, "&dstbf/DSTB%p") != "" {
+			return nil, fmt.Errorf("%w: pattern %q is not made up of number types", ErrInvalidRule, r.Pattern)
+		}
+
+		if r.Action.handler == nil || r.Action.arity < 1 {
+			return nil, fmt.Errorf("%w: pattern %q has no action", ErrInvalidRule, r.Pattern)
+		}
+
+		if len(r.Pattern) < r.Action.arity {
+			return nil, fmt.Errorf("%w: pattern %q is too short for action %s", ErrInvalidRule, r.Pattern, r.Action)
+		}
+
+		for _, prev := range sorted[:i] {
+			if strings.Contains(r.Pattern, prev.Pattern) {
+				return nil, fmt.Errorf("%w: pattern %q is unreachable, as %q is matched before it", ErrInvalidRule, r.Pattern, prev.Pattern)
+			}
+		}
+	}
+
+	return &RuleSet{sorted}, nil
+}
+
+func mustRuleSet(rules ...Rule) *RuleSet {
+	rs, err := NewRuleSet(rules...)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// Rules returns a copy of the rules in the order they are tried
+func (rs *RuleSet) Rules() []Rule {
+	return append([]Rule(nil), rs.rules...)
+}
+
+// Reduce destructivley converts the numbers set to its minimal form based on
+// the rules. NB: the input slice will be modified significantly
+func (rs *RuleSet) reduce(ns numbers) numbers {
+	ns, _ = rs.trace(ns, nil, nil)
+	return ns
+}
+
+// Trace reduces the numbers like reduce. If spans is not nil, the spans of the
+// numbers are merged as they are combined. If step is not nil, it is called
+// after each rule is applied.
+func (rs *RuleSet) trace(ns numbers, spans []Span, step func(Step)) (numbers, []Span) {
+	for found := true; found; {
+		found = false
+		pattern := ns.pattern()
+		for ri, r := range rs.rules {
+			if idx := strings.LastIndex(pattern, r.Pattern); idx >= 0 {
+				found = true
+				n, at := len(ns), r.Action.merged(ns, idx)
+				ns = r.Action.handler(ns, idx)
+				for i := len(ns); spans != nil && i < n; i++ {
+					spans = mergeSpan(spans, at)
+				}
+				if step != nil {
+					step(Step{pattern, r, ri, idx, traceStrings(ns)})
+				}
+				break
+			}
+		}
+	}
+	return ns, spans
+}
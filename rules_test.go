@@ -0,0 +1,126 @@
+package numwords
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRules_NewRuleSet(t *testing.T) {
+	t.Parallel()
+
+	rs, err := NewRuleSet(
+		Rule{"sb", 20, ActionCombine, "one hundred => 100"},
+		Rule{"ts", 10, ActionCombine, "twenty three => 23"},
+		Rule{"bs", 20, ActionCombine, "hundred one => 101"},
+	)
+	if assert.NoError(t, err) {
+		var patterns []string
+		for _, r := range rs.Rules() {
+			patterns = append(patterns, r.Pattern)
+		}
+		assert.Equal(t, []string{"ts", "sb", "bs"}, patterns)
+	}
+
+	invalid := []struct {
+		rules []Rule
+		err   string
+	}{
+		{[]Rule{{"", 0, ActionAdd, ""}}, `invalid rule: pattern "" is not made up of number types`},
+		{[]Rule{{"sx", 0, ActionAdd, ""}}, `invalid rule: pattern "sx" is not made up of number types`},
+		{[]Rule{{"sb", 0, Action{}, ""}}, `invalid rule: pattern "sb" has no action`},
+		{[]Rule{{"sb", 0, NewAction("none", 0, nil), ""}}, `invalid rule: pattern "sb" has no action`},
+		{[]Rule{{"s", 0, ActionAdd, ""}}, `invalid rule: pattern "s" is too short for action add`},
+		{[]Rule{{"b&", 0, ActionAddAnd, ""}}, `invalid rule: pattern "b&" is too short for action addAnd`},
+		{[]Rule{{"sb", 10, ActionCombine, ""}, {"bsb", 20, ActionCombineToLowest, ""}}, `invalid rule: pattern "bsb" is unreachable, as "sb" is matched before it`},
+		{[]Rule{{"sb", 10, ActionCombine, ""}, {"sb", 20, ActionMultiply, ""}}, `invalid rule: pattern "sb" is unreachable, as "sb" is matched before it`},
+	}
+
+	for _, test := range invalid {
+		_, err := NewRuleSet(test.rules...)
+		assert.True(t, errors.Is(err, ErrInvalidRule), test.err)
+		assert.EqualError(t, err, test.err)
+	}
+}
+
+func TestRules_DefaultRules(t *testing.T) {
+	t.Parallel()
+
+	rules := DefaultRules()
+	assert.Equal(t, describeRules(defaultRules), describeRules(rules))
+
+	rules[0].Pattern = "xx"
+	assert.Equal(t, "ts", defaultRules[0].Pattern, "a copy is returned")
+
+	rs, err := NewRuleSet(DefaultRules()...)
+	if assert.NoError(t, err) {
+		assert.Equal(t, describeRules(defaultRules), describeRules(rs.Rules()))
+	}
+}
+
+// describeRules renders the rules as strings, as actions cannot be compared
+func describeRules(rules []Rule) (out []string) {
+	for _, r := range rules {
+		out = append(out, fmt.Sprintf("%d %s %s: %s", r.Priority, r.Pattern, r.Action, r.Description))
+	}
+	return
+}
+
+func TestRules_Reduce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"one hundred two thousand", "102000"},
+		{"one thousand two hundred", "1200"},
+		{"one hundred twentieth", "120th"},
+		{"two hundred thousandth", "200000th"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, ParseString(test.in), test.in)
+	}
+}
+
+func TestRules_Action(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "combineToLowest", ActionCombineToLowest.String())
+}
+
+func TestRules_NewAction(t *testing.T) {
+	t.Parallel()
+
+	// reads digits spoken in pairs, such as "ten three" => 1003
+	pairs := NewAction("pairs", 2, func(terms []Term) []Term {
+		a, b := terms[0], terms[1]
+		return []Term{{a.Numerator*100 + b.Numerator, 1, "_", false}}
+	})
+
+	rs, err := NewRuleSet(append([]Rule{{"ds", -1, pairs, "ten three => 1003"}}, DefaultRules()...)...)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	p := New(WithRules(rs))
+	assert.Equal(t, "1003 apples", p.ParseString("ten three apples"))
+	assert.Equal(t, "23 apples", p.ParseString("twenty three apples"), "default rules still apply")
+
+	n := p.ParseAll("room ten three")
+	if assert.Len(t, n, 1) {
+		assert.Equal(t, Number{1003, 1, KindCardinal, Span{5, 14}}, n[0])
+	}
+
+	// an action that changes nothing marks the first number done
+	same := NewAction("same", 2, func(terms []Term) []Term {
+		return terms
+	})
+	rs, err = NewRuleSet(Rule{"ss", 0, same, "two three => 2 3"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2 3", New(WithRules(rs)).ParseString("two three"))
+	}
+}
@@ -0,0 +1,55 @@
+package numwords
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrMalformed is returned by ParseInt and ParseFloat in strict mode if the
+// numbers in the input are not a single grammatical number. It is wrapped by a
+// MalformedError describing the offending word.
+var ErrMalformed = errors.New("the input is a malformed number")
+
+// MalformedError describes the first word of the input that breaks the grammar
+// of numbers in strict mode.
+type MalformedError struct {
+	// Word is the offending word.
+	Word string
+
+	// Index is the position of the word among the words of the input.
+	Index int
+
+	// Span is the location of the word in the input string.
+	Span Span
+
+	// Reason describes how the word breaks the grammar: "repeated scale" (eg,
+	// "hundred hundred"), "scale out of order" (eg, "million thousand"), "not a
+	// year" (eg, "thirty ten"), "unexpected number" (eg, "five five") or
+	// "unexpected and".
+	Reason string
+}
+
+// Error describes the malformed word
+func (e *MalformedError) Error() string {
+	return fmt.Sprintf("%v: %s at %q (word %d)", ErrMalformed, e.Reason, e.Word, e.Index+1)
+}
+
+// Unwrap returns ErrMalformed
+func (e *MalformedError) Unwrap() error {
+	return ErrMalformed
+}
+
+// ParseStrict parses the numbers read from the tokens from start, one per
+// token, with the grammar, returning a MalformedError for the first number that
+// breaks it.
+func parseStrict(toks []token, start int, ns numbers) (numbers, error) {
+	g := &grammar{ns: ns}
+	trees := g.phrases()
+
+	if g.reason != "" {
+		i := start + g.bad
+		return nil, &MalformedError{toks[i].core, i, toks[i].Span, g.reason}
+	}
+
+	return values(trees), nil
+}
@@ -0,0 +1,110 @@
+package numwords
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrict_Malformed(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithStrict(true))
+
+	tests := []struct {
+		in     string
+		word   string
+		index  int
+		span   Span
+		reason string
+	}{
+		{"hundred hundred", "hundred", 1, Span{8, 15}, "repeated scale"},
+		{"five hundred hundred", "hundred", 2, Span{13, 20}, "repeated scale"},
+		{"thousand two thousand", "thousand", 2, Span{13, 21}, "repeated scale"},
+		{"one thousand five hundred thousand", "thousand", 4, Span{26, 34}, "repeated scale"},
+		{"two thousand three thousand", "thousand", 3, Span{19, 27}, "repeated scale"},
+		{"three hundred two hundred", "hundred", 3, Span{18, 25}, "repeated scale"},
+		{"two million three million", "million", 3, Span{18, 25}, "repeated scale"},
+		{"five thousand six hundred seven thousand", "thousand", 5, Span{32, 40}, "repeated scale"},
+		{"fifteen hundred three thousand", "thousand", 3, Span{22, 30}, "scale out of order"},
+		{"million thousand", "thousand", 1, Span{8, 16}, "scale out of order"},
+		{"thousand million", "million", 1, Span{9, 16}, "scale out of order"},
+		{"five five", "five", 1, Span{5, 9}, "unexpected number"},
+		{"two three", "three", 1, Span{4, 9}, "unexpected number"},
+		{"twenty five twenty", "twenty", 2, Span{12, 18}, "unexpected number"},
+		{"thirty ten", "ten", 1, Span{7, 10}, "not a year"},
+		{"ten eleven", "eleven", 1, Span{4, 10}, "not a year"},
+	}
+
+	for _, test := range tests {
+		_, err := p.ParseInt(test.in)
+		assert.True(t, errors.Is(err, ErrMalformed), test.in)
+
+		var me *MalformedError
+		if assert.True(t, errors.As(err, &me), test.in) {
+			assert.Equal(t, test.word, me.Word, test.in)
+			assert.Equal(t, test.index, me.Index, test.in)
+			assert.Equal(t, test.span, me.Span, test.in)
+			assert.Equal(t, test.reason, me.Reason, test.in)
+		}
+
+		_, err = p.ParseFloat(test.in)
+		assert.True(t, errors.Is(err, ErrMalformed), test.in)
+	}
+
+	_, err := p.ParseInt("five five")
+	assert.EqualError(t, err, `the input is a malformed number: unexpected number at "five" (word 2)`)
+
+	_, err = p.ParseInt("five apples")
+	assert.Equal(t, ErrNonNumber, err)
+
+	_, err = ParseInt("five five")
+	assert.Equal(t, ErrManyNumbers, err, "not strict by default")
+}
+
+func TestStrict_WellFormed(t *testing.T) {
+	t.Parallel()
+
+	p := New(WithStrict(true))
+
+	tests := []struct {
+		in  string
+		out float64
+	}{
+		{"twenty ten", 2010},
+		{"nineteen eighty eight", 1988},
+		{"two hundred five thousand", 205000},
+		{"two hundred five thousand six hundred", 205600},
+		{"one million two hundred thousand", 1200000},
+		{"one million two hundred thousand and seven", 1200007},
+		{"twelve hundred", 1200},
+		{"100 thousand", 100000},
+		{"a dozen", 12},
+		{"twenty dozen", 240},
+		{"one hundred dozen", 1200},
+		{"two and a half", 2.5},
+		{"one fifth", 0.2},
+	}
+
+	for _, test := range tests {
+		f, err := p.ParseFloat(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, f, test.in)
+		}
+	}
+
+	for _, test := range parseFloatTests {
+		f, err := p.ParseFloat(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, f, test.in)
+		}
+	}
+
+	for _, test := range parseIntTests {
+		i, err := p.ParseInt(test.in)
+		if assert.NoError(t, err, test.in) {
+			assert.Equal(t, test.out, i, test.in)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package numwords
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Span is the location of text within an input string, as byte offsets
+type Span struct {
+	Start, End int
+}
+
+// Union returns the smallest span covering both spans
+func (s Span) union(o Span) Span {
+	if o.Start < s.Start {
+		s.Start = o.Start
+	}
+	if o.End > s.End {
+		s.End = o.End
+	}
+	return s
+}
+
+// MergeSpan merges the ith span into the one before it, or the one after it if
+// it is the first.
+func mergeSpan(spans []Span, i int) []Span {
+	j := i - 1
+	if j < 0 {
+		j = i + 1
+	}
+	spans[j] = spans[j].union(spans[i])
+	return append(spans[:i], spans[i+1:]...)
+}
+
+// SpanOf returns the span covering the words of the tokens in s
+func spanOf(s string, toks []token) Span {
+	if len(toks) == 0 {
+		return Span{}
+	}
+	return coreSpan(s, toks[0].Span).union(coreSpan(s, toks[len(toks)-1].Span))
+}
+
+// CoreSpan returns the span of the text of s within sp, without any
+// punctuation split from either end of it (eg, "seven" in "(seven)").
+func coreSpan(s string, sp Span) Span {
+	t := splitToken(s[sp.Start:sp.End])
+	start := sp.Start + len(t.pre)
+	return Span{start, start + len(t.core)}
+}
+
+// Token is a word produced by a Tokenizer
+type Token struct {
+	// Text is the text of the word, s[Start:End] of the tokenized string.
+	Text string
+
+	// Span is the location of the word in the tokenized string.
+	Span
+}
+
+// Tokenizer splits text into the words read by a Parser. Tokens may contain
+// punctuation, hyphens and typographic quotes, which the Parser separates and
+// normalizes. Tokens separated only by punctuation (eg, "(" "seven" ")") are
+// rejoined, so tokenizers that split punctuation into tokens of its own are
+// read the same as those that do not.
+type Tokenizer interface {
+	Tokenize(s string) []Token
+}
+
+// TokenizerFunc is an adapter allowing ordinary functions to be used as a
+// Tokenizer.
+type TokenizerFunc func(s string) []Token
+
+// Tokenize calls f(s)
+func (f TokenizerFunc) Tokenize(s string) []Token {
+	return f(s)
+}
+
+// WordTokenizer splits text into words at whitespace. Thin and no-break spaces
+// between two digits are treated as group separators instead of word
+// boundaries (eg, "1 000 000"). It is the default Tokenizer.
+type WordTokenizer struct{}
+
+// Tokenize splits s into words at whitespace
+func (WordTokenizer) Tokenize(s string) (out []Token) {
+	start, prev := -1, rune(-1)
+
+	for i, r := range s {
+		if !unicode.IsSpace(r) || (start >= 0 && isGroupSpace(r) && isDigit(prev) && nextIsDigit(s, i)) {
+			if start < 0 {
+				start = i
+			}
+		} else if start >= 0 {
+			out = append(out, Token{s[start:i], Span{start, i}})
+			start = -1
+		}
+		prev = r
+	}
+
+	if start >= 0 {
+		out = append(out, Token{s[start:], Span{start, len(s)}})
+	}
+
+	return
+}
+
+// UnicodeTokenizer splits text into words at the word boundaries defined by
+// Unicode Standard Annex #29, discarding whitespace. Zero-width spaces are
+// ignored rather than treated as boundaries.
+type UnicodeTokenizer struct{}
+
+// Tokenize splits s into words at Unicode word boundaries
+func (UnicodeTokenizer) Tokenize(s string) (out []Token) {
+	for _, sp := range wordBreaks(s) {
+		if r, _ := utf8.DecodeRuneInString(s[sp.Start:]); unicode.IsSpace(r) {
+			continue
+		}
+		out = append(out, Token{s[sp.Start:sp.End], sp})
+	}
+	return
+}
+
+// NextIsDigit returns true if the rune after the one at index i is a digit
+func nextIsDigit(s string, i int) bool {
+	_, size := utf8.DecodeRuneInString(s[i:])
+	next, _ := utf8.DecodeRuneInString(s[i+size:])
+	return isDigit(next)
+}
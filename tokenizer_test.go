@@ -0,0 +1,88 @@
+package numwords
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizer_WordTokenizer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []Token
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"one two", []Token{{"one", Span{0, 3}}, {"two", Span{4, 7}}}},
+		{" twenty-one\tapples\n", []Token{{"twenty-one", Span{1, 11}}, {"apples", Span{12, 18}}}},
+		{"1\u2009000 dollars", []Token{{"1\u2009000", Span{0, 7}}, {"dollars", Span{8, 15}}}},
+		{"3\u00a0apples", []Token{{"3", Span{0, 1}}, {"apples", Span{3, 9}}}},
+		{"3\u00a0", []Token{{"3", Span{0, 1}}}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, WordTokenizer{}.Tokenize(test.in), "%q", test.in)
+	}
+}
+
+func TestTokenizer_UnicodeTokenizer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"", nil},
+		{"The quick (\"brown\") fox can't jump 32.3 feet, right?", []string{"The", "quick", "(", "\"", "brown", "\"", ")", "fox", "can't", "jump", "32.3", "feet", ",", "right", "?"}},
+		{"twenty-one", []string{"twenty", "-", "one"}},
+		{"1,000,000 5kg", []string{"1,000,000", "5kg"}},
+		{"三百 apples", []string{"三", "百", "apples"}},
+		{"fif\u200bteen", []string{"fif\u200bteen"}},
+		{"snake_case", []string{"snake_case"}},
+	}
+
+	for _, test := range tests {
+		var actual []string
+		for _, tok := range (UnicodeTokenizer{}).Tokenize(test.in) {
+			assert.Equal(t, test.in[tok.Start:tok.End], tok.Text, "%q", test.in)
+			actual = append(actual, tok.Text)
+		}
+		assert.Equal(t, test.expected, actual, "%q", test.in)
+	}
+}
+
+func TestTokenizer_TokenizerFunc(t *testing.T) {
+	t.Parallel()
+
+	var f Tokenizer = TokenizerFunc(func(s string) []Token {
+		return []Token{{strings.ToUpper(s), Span{0, len(s)}}}
+	})
+
+	assert.Equal(t, []Token{{"FOO", Span{0, 3}}}, f.Tokenize("foo"))
+}
+
+func TestTokenizer_CoreSpan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		span Span
+		out  Span
+	}{
+		{"seven", Span{0, 5}, Span{0, 5}},
+		{"(seven),", Span{0, 8}, Span{1, 6}},
+		{"‘twelve’", Span{0, 12}, Span{3, 9}},
+		{"50%", Span{0, 3}, Span{0, 3}},
+		{"...", Span{0, 3}, Span{0, 3}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.out, coreSpan(test.in, test.span), test.in)
+	}
+
+	assert.Equal(t, []Span{{0, 9}, {10, 12}}, mergeSpan([]Span{{0, 3}, {4, 9}, {10, 12}}, 1))
+	assert.Equal(t, []Span{{0, 9}}, mergeSpan([]Span{{0, 3}, {4, 9}}, 0))
+}
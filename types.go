@@ -9,11 +9,15 @@ const (
 	numTens
 	numBig
 	numFraction
+	numSlashFraction
 	numDirectOrdinal
 	numSingleOrdinal
 	numTensOrdinal
 	numBigOrdinal
+	numPercentSign
+	numPercent
 	numDone
+	numYear // a colloquial year, which is also done
 )
 
 var typeStrings = map[numberType]string{
@@ -23,10 +27,13 @@ var typeStrings = map[numberType]string{
 	numTens:          "t",
 	numBig:           "b",
 	numFraction:      "f",
+	numSlashFraction: "/",
 	numDirectOrdinal: "D",
 	numSingleOrdinal: "S",
 	numTensOrdinal:   "T",
 	numBigOrdinal:    "B",
+	numPercentSign:   "%",
+	numPercent:       "p",
 }
 
 func (t numberType) String() string {
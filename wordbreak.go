@@ -0,0 +1,209 @@
+package numwords
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// wordBreakClass is the Word_Break property of a rune, as defined by Unicode
+// Standard Annex #29. The properties are approximated from general categories
+// and scripts, as the standard library does not include them.
+type wordBreakClass int8
+
+const (
+	wbOther wordBreakClass = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbRegionalIndicator
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+)
+
+// noWordScripts are scripts whose letters are not joined into words, as they
+// are written without spaces (eg, each Han ideograph is a word).
+var noWordScripts = []*unicode.RangeTable{
+	unicode.Han,
+	unicode.Hiragana,
+	unicode.Thai,
+	unicode.Lao,
+	unicode.Khmer,
+	unicode.Myanmar,
+}
+
+func wordBreakClassOf(r rune) wordBreakClass {
+	switch r {
+	case '\r':
+		return wbCR
+	case '\n':
+		return wbLF
+	case '\v', '\f', '\u0085', '\u2028', '\u2029':
+		return wbNewline
+	case '\u200b', '\u200c', '\u200d':
+		return wbExtend
+	case '\'':
+		return wbSingleQuote
+	case '"':
+		return wbDoubleQuote
+	case '.', '\u2018', '\u2019', '\u2024', '\ufe52', '\uff07', '\uff0e':
+		return wbMidNumLet
+	case ':', '\u00b7', '\u0387', '\u05f4', '\u2027', '\ufe13', '\ufe55', '\uff1a':
+		return wbMidLetter
+	case ',', ';', '\u037e', '\u0589', '\u060c', '\u060d', '\u066c', '\u07f8', '\u2044', '\ufe10', '\ufe14', '\ufe50', '\ufe54', '\uff0c', '\uff1b':
+		return wbMidNum
+	case '\u30fc':
+		return wbKatakana
+	}
+
+	switch {
+	case r >= '\U0001f1e6' && r <= '\U0001f1ff':
+		return wbRegionalIndicator
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Cf):
+		return wbExtend
+	case unicode.Is(unicode.Katakana, r):
+		return wbKatakana
+	case unicode.Is(unicode.Hebrew, r) && unicode.IsLetter(r):
+		return wbHebrewLetter
+	case unicode.IsLetter(r) && !unicode.In(r, noWordScripts...):
+		return wbALetter
+	case isDigit(r):
+		return wbNumeric
+	case unicode.Is(unicode.Pc, r):
+		return wbExtendNumLet
+	case unicode.Is(unicode.Zs, r):
+		return wbWSegSpace
+	}
+
+	return wbOther
+}
+
+func (c wordBreakClass) isAHLetter() bool {
+	return c == wbALetter || c == wbHebrewLetter
+}
+
+func (c wordBreakClass) isMidLetter() bool {
+	return c == wbMidLetter || c == wbMidNumLet || c == wbSingleQuote
+}
+
+func (c wordBreakClass) isMidNum() bool {
+	return c == wbMidNum || c == wbMidNumLet || c == wbSingleQuote
+}
+
+func (c wordBreakClass) isNewline() bool {
+	return c == wbCR || c == wbLF || c == wbNewline
+}
+
+// wordUnit is a rune along with any extending runes following it (eg,
+// combining marks), which are treated as a single rune when finding word
+// boundaries.
+type wordUnit struct {
+	class wordBreakClass
+	Span
+}
+
+// WordBreaks splits s into segments at its word boundaries, including segments
+// of whitespace and punctuation.
+func wordBreaks(s string) (out []Span) {
+	units := wordUnits(s)
+
+	start, ri := 0, 0
+	for i := range units {
+		if units[i].class == wbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		if i > 0 && isWordBreak(units, i, ri) {
+			out = append(out, Span{units[start].Start, units[i].Start})
+			start = i
+		}
+	}
+
+	if len(units) > 0 {
+		out = append(out, Span{units[start].Start, len(s)})
+	}
+
+	return
+}
+
+// WordUnits groups the runes of s into units, attaching extending runes to
+// the rune before them except after newlines (WB4).
+func wordUnits(s string) (out []wordUnit) {
+	for i, r := range s {
+		c := wordBreakClassOf(r)
+		end := i + utf8.RuneLen(r)
+
+		if last := len(out) - 1; last >= 0 && c == wbExtend && !out[last].class.isNewline() {
+			out[last].End = end
+			continue
+		}
+
+		out = append(out, wordUnit{c, Span{i, end}})
+	}
+	return
+}
+
+// IsWordBreak returns true if there is a word boundary before the ith unit. ri
+// is the number of consecutive regional indicators ending at the ith unit.
+func isWordBreak(units []wordUnit, i, ri int) bool {
+	class := func(j int) wordBreakClass {
+		if j < 0 || j >= len(units) {
+			return wbOther
+		}
+		return units[j].class
+	}
+
+	prev2, prev, cur, next := class(i-2), class(i-1), class(i), class(i+1)
+
+	switch {
+	case prev == wbCR && cur == wbLF: // WB3
+		return false
+	case prev.isNewline(), cur.isNewline(): // WB3a, WB3b
+		return true
+	case prev == wbWSegSpace && cur == wbWSegSpace: // WB3d
+		return false
+	case prev.isAHLetter() && cur.isAHLetter(): // WB5
+		return false
+	case prev.isAHLetter() && cur.isMidLetter() && next.isAHLetter(): // WB6
+		return false
+	case prev2.isAHLetter() && prev.isMidLetter() && cur.isAHLetter(): // WB7
+		return false
+	case prev == wbHebrewLetter && cur == wbSingleQuote: // WB7a
+		return false
+	case prev == wbHebrewLetter && cur == wbDoubleQuote && next == wbHebrewLetter: // WB7b
+		return false
+	case prev2 == wbHebrewLetter && prev == wbDoubleQuote && cur == wbHebrewLetter: // WB7c
+		return false
+	case prev == wbNumeric && cur == wbNumeric: // WB8
+		return false
+	case prev.isAHLetter() && cur == wbNumeric: // WB9
+		return false
+	case prev == wbNumeric && cur.isAHLetter(): // WB10
+		return false
+	case prev2 == wbNumeric && prev.isMidNum() && cur == wbNumeric: // WB11
+		return false
+	case prev == wbNumeric && cur.isMidNum() && next == wbNumeric: // WB12
+		return false
+	case prev == wbKatakana && cur == wbKatakana: // WB13
+		return false
+	case cur == wbExtendNumLet && (prev.isAHLetter() || prev == wbNumeric || prev == wbKatakana || prev == wbExtendNumLet): // WB13a
+		return false
+	case prev == wbExtendNumLet && (cur.isAHLetter() || cur == wbNumeric || cur == wbKatakana): // WB13b
+		return false
+	case prev == wbRegionalIndicator && cur == wbRegionalIndicator: // WB15, WB16
+		return ri%2 == 1
+	}
+
+	return true // WB999
+}
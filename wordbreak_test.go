@@ -0,0 +1,38 @@
+package numwords
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordBreak_WordBreaks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in       string
+		expected []string
+	}{
+		{"", nil},
+		{"a b", []string{"a", " ", "b"}},
+		{"a  b", []string{"a", "  ", "b"}},
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"éte", []string{"éte"}},
+		{"can't", []string{"can't"}},
+		{"can'", []string{"can", "'"}},
+		{"3.14", []string{"3.14"}},
+		{"3.", []string{"3", "."}},
+		{"a.b", []string{"a.b"}},
+		{"A1", []string{"A1"}},
+		{"\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7", []string{"\U0001f1fa\U0001f1f8", "\U0001f1eb\U0001f1f7"}},
+		{"カタカナ", []string{"カタカナ"}},
+	}
+
+	for _, test := range tests {
+		var actual []string
+		for _, sp := range wordBreaks(test.in) {
+			actual = append(actual, test.in[sp.Start:sp.End])
+		}
+		assert.Equal(t, test.expected, actual, "%q", test.in)
+	}
+}